@@ -2,8 +2,6 @@ package partitionv1
 
 import (
 	"context"
-	"errors"
-	"io"
 	"time"
 
 	apic "github.com/antinvestor/apis"
@@ -15,6 +13,26 @@ import (
 
 var ctxKeyService = apic.CtxServiceKey("partitionClientKey")
 
+const (
+	defaultUnaryTimeout  = time.Second * 5
+	defaultStreamTimeout = time.Second * 30
+)
+
+// streamingMethods are the server streaming rpcs, these get the stream timeout by default.
+var streamingMethods = map[string]bool{
+	"ListTenant":          true,
+	"ListPartition":       true,
+	"ListChildPartitions": true,
+	"GetPartitionSubtree": true,
+	"ListAccessByProfile": true,
+	"ListPartitionAccess": true,
+}
+
+// longLivedMethods stream for as long as the caller wants, these get no timeout by default.
+var longLivedMethods = map[string]bool{
+	"Watch": true,
+}
+
 func defaultPartitionClientOptions() []apic.ClientOption {
 	return []apic.ClientOption{
 		apic.WithEndpoint("partitions.api.antinvestor.com:443"),
@@ -48,19 +66,43 @@ type PartitionClient struct {
 
 	// The x-ant-* metadata to be sent with each request.
 	xMetadata metadata.MD
+
+	// Application name and version pairs reported in the x-ai-api-client header.
+	clientInfo []string
+
+	// Additional metadata supplied through WithMetadata.
+	callMetadata metadata.MD
+
+	// Timeouts applied to calls whose context has no deadline.
+	unaryTimeout   time.Duration
+	streamTimeout  time.Duration
+	methodTimeouts map[string]time.Duration
+
+	// Policy applied to unary calls failing with a retryable code.
+	retryPolicy RetryPolicy
+
+	// Read-through cache of partition and access lookups, nil unless enabled with WithCache.
+	cache *lookupCache
+
+	// Set by WithoutValidation to send requests without validating them first.
+	skipValidation bool
 }
 
 // InstantiatePartitionsClient creates a new partitions client based on supplied connection
 func InstantiatePartitionsClient(
 	clientConnection *grpc.ClientConn,
-	partitionServiceClient PartitionServiceClient) *PartitionClient {
+	partitionServiceClient PartitionServiceClient, opts ...apic.ClientOption) *PartitionClient {
 
 	cl := &PartitionClient{
-		clientConn: clientConnection,
-		client:     partitionServiceClient,
+		clientConn:    clientConnection,
+		client:        partitionServiceClient,
+		unaryTimeout:  defaultUnaryTimeout,
+		streamTimeout: defaultStreamTimeout,
+		retryPolicy:   DefaultRetryPolicy(),
 	}
 
-	cl.setClientInfo()
+	applyClientOptions(cl, opts)
+	cl.setClientInfo(cl.clientInfo...)
 	return cl
 }
 
@@ -76,7 +118,7 @@ func NewPartitionsClient(ctx context.Context, opts ...apic.ClientOption) (*Parti
 
 	partSvcClient := NewPartitionServiceClient(connPool)
 
-	return InstantiatePartitionsClient(connPool, partSvcClient), nil
+	return InstantiatePartitionsClient(connPool, partSvcClient, opts...), nil
 }
 
 // Close closes the connection to the API service. The user should invoke this when
@@ -86,58 +128,111 @@ func (partCl *PartitionClient) Close() error {
 }
 
 // setClientInfo sets the name and version of the application in
-// the `x-goog-api-client` header passed on each request. Intended for
-// use by Google-written clients.
+// the `x-ai-api-client` header passed on each request, together with
+// any metadata supplied through WithMetadata.
 func (partCl *PartitionClient) setClientInfo(keyval ...string) {
 	kv := append([]string{"gl-go", apic.VersionGo()}, keyval...)
 	kv = append(kv, "grpc", grpc.Version)
-	partCl.xMetadata = metadata.Pairs("x-ai-api-client", apic.XAntHeader(kv...))
+	partCl.xMetadata = metadata.Join(
+		metadata.Pairs("x-ai-api-client", apic.XAntHeader(kv...)), partCl.callMetadata)
+}
+
+// callContext attaches the client metadata to the outgoing metadata already present on ctx
+// and bounds the call to method by the configured timeout, unless the caller already set a deadline.
+// Every unary and streaming call to the service is made with a context derived from it.
+func (partCl *PartitionClient) callContext(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Join(md, partCl.xMetadata))
+
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+
+	timeout := partCl.timeout(method)
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// timeout returns the per method override for method if any, otherwise the default for its kind of call.
+func (partCl *PartitionClient) timeout(method string) time.Duration {
+	if timeout, ok := partCl.methodTimeouts[method]; ok {
+		return timeout
+	}
+	if longLivedMethods[method] {
+		return 0
+	}
+	if streamingMethods[method] {
+		return partCl.streamTimeout
+	}
+	return partCl.unaryTimeout
 }
 
-// ListTenants gets a list of all the tenants with query filtering against id and properties
+// ListTenants gets a list of all the tenants with query filtering against id and properties.
+// If the stream fails midway the tenants received so far are returned with a *PartialResultError,
+// use IterateTenants to process large listings incrementally.
 func (partCl *PartitionClient) ListTenants(
 	ctx context.Context,
 	query string,
 	count uint,
 	page uint) ([]*TenantObject, error) {
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
-	defer cancel()
 
-	request := SearchRequest{
-		Query: query,
-		Count: uint32(count),
-		Page:  uint32(page),
+	tenants, err := partCl.IterateTenants(ctx, query, count, page)
+	if err != nil {
+		return nil, err
 	}
+	defer tenants.Close()
 
 	var tenantList []*TenantObject
+	for tenants.Next() {
+		tenantList = append(tenantList, tenants.Tenant())
+	}
+	return tenantList, tenants.Err()
+}
 
-	tenantStream, err := partCl.client.ListTenant(cancelCtx, &request)
+// ListTenantsPage gets a page of the tenants matching the query using cursor based paging.
+// An empty pageToken starts from the first page, the returned token continues after the tenants
+// returned and is empty once the last page was reached.
+func (partCl *PartitionClient) ListTenantsPage(
+	ctx context.Context,
+	query string,
+	count uint,
+	pageToken string) ([]*TenantObject, string, error) {
+
+	request := SearchRequest{
+		Query:     query,
+		Count:     uint32(count),
+		PageToken: pageToken,
+	}
+
+	tenants, err := partCl.SearchTenants(ctx, &request)
 	if err != nil {
-		return tenantList, err
+		return nil, "", err
 	}
-	for {
-		tenantObj, err := tenantStream.Recv()
-		if errors.Is(err, io.EOF) {
-			return tenantList, nil
-		}
-		if err != nil {
-			return tenantList, err
-		}
+	defer tenants.Close()
 
-		tenantList = append(tenantList, tenantObj)
+	var tenantList []*TenantObject
+	for tenants.Next() {
+		tenantList = append(tenantList, tenants.Tenant())
 	}
+	return tenantList, tenants.NextPageToken(), tenants.Err()
 }
 
 // GetTenant Obtains the tenant by the id  supplied.
 func (partCl *PartitionClient) GetTenant(ctx context.Context, tenantId string) (*TenantObject, error) {
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "GetTenant")
 	defer cancel()
 
 	request := GetRequest{
 		Id: tenantId,
 	}
 
-	return partCl.client.GetTenant(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "GetTenant", partCl.client.GetTenant, &request)
 }
 
 // NewTenant used to create a new tenant instance.
@@ -148,7 +243,7 @@ func (partCl *PartitionClient) NewTenant(
 	name string,
 	description string,
 	props map[string]string) (*TenantObject, error) {
-	profileCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	profileCtx, cancel := partCl.callContext(ctx, "CreateTenant")
 	defer cancel()
 
 	request := TenantRequest{
@@ -157,41 +252,114 @@ func (partCl *PartitionClient) NewTenant(
 		Properties:  props,
 	}
 
-	return partCl.client.CreateTenant(profileCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(idempotentContext(profileCtx), partCl, "CreateTenant", partCl.client.CreateTenant, &request)
+}
+
+// UpdateTenant changes the name, description and properties of an existing tenant.
+func (partCl *PartitionClient) UpdateTenant(ctx context.Context, tenantId string,
+	name string, description string, props map[string]string) (*TenantObject, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "UpdateTenant")
+	defer cancel()
+
+	request := TenantUpdateRequest{
+		TenantId:    tenantId,
+		Name:        name,
+		Description: description,
+		Properties:  props,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "UpdateTenant", partCl.client.UpdateTenant, &request)
+}
+
+// SuspendTenant deactivates a tenant, preventing new partitions from being created for it.
+func (partCl *PartitionClient) SuspendTenant(ctx context.Context, tenantId string) (*TenantObject, error) {
+	cancelCtx, cancel := partCl.callContext(ctx, "SuspendTenant")
+	defer cancel()
+
+	request := GetRequest{
+		Id: tenantId,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "SuspendTenant", partCl.client.SuspendTenant, &request)
+}
+
+// ReactivateTenant restores a suspended tenant to the active state.
+func (partCl *PartitionClient) ReactivateTenant(ctx context.Context, tenantId string) (*TenantObject, error) {
+	cancelCtx, cancel := partCl.callContext(ctx, "ReactivateTenant")
+	defer cancel()
+
+	request := GetRequest{
+		Id: tenantId,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "ReactivateTenant", partCl.client.ReactivateTenant, &request)
 }
 
-// ListPartitions obtains partitions tied to the query parameter
+// ListPartitions obtains partitions tied to the query parameter.
+// If the stream fails midway the partitions received so far are returned with a *PartialResultError,
+// use IteratePartitions to process large listings incrementally.
 func (partCl *PartitionClient) ListPartitions(
 	ctx context.Context,
 	query string,
 	count uint,
 	page uint) ([]*PartitionObject, error) {
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
-	defer cancel()
 
-	request := SearchRequest{
-		Query: query,
-		Count: uint32(count),
-		Page:  uint32(page),
+	partitions, err := partCl.IteratePartitions(ctx, query, count, page)
+	if err != nil {
+		return nil, err
 	}
+	defer partitions.Close()
 
 	var partitionList []*PartitionObject
+	for partitions.Next() {
+		partitionList = append(partitionList, partitions.Partition())
+	}
+	return partitionList, partitions.Err()
+}
+
+// ListPartitionsPage gets a page of the partitions matching the query using cursor based paging.
+// An empty pageToken starts from the first page, the returned token continues after the partitions
+// returned and is empty once the last page was reached.
+func (partCl *PartitionClient) ListPartitionsPage(
+	ctx context.Context,
+	query string,
+	count uint,
+	pageToken string) ([]*PartitionObject, string, error) {
+
+	request := SearchRequest{
+		Query:     query,
+		Count:     uint32(count),
+		PageToken: pageToken,
+	}
 
-	partitions, err := partCl.client.ListPartition(cancelCtx, &request)
+	partitions, err := partCl.SearchPartitions(ctx, &request)
 	if err != nil {
-		return partitionList, err
+		return nil, "", err
 	}
-	for {
-		partitionObj, err := partitions.Recv()
-		if errors.Is(err, io.EOF) {
-			return partitionList, nil
-		}
-		if err != nil {
-			return partitionList, err
-		}
+	defer partitions.Close()
 
-		partitionList = append(partitionList, partitionObj)
+	var partitionList []*PartitionObject
+	for partitions.Next() {
+		partitionList = append(partitionList, partitions.Partition())
 	}
+	return partitionList, partitions.NextPageToken(), partitions.Err()
 }
 
 // NewPartition Creates a further logical multitenant environment at a softer level.
@@ -203,14 +371,24 @@ func (partCl *PartitionClient) NewPartition(ctx context.Context, tenantId string
 
 // GetPartition Obtains the partition by the id  supplied.
 func (partCl *PartitionClient) GetPartition(ctx context.Context, partitionId string) (*PartitionObject, error) {
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	return cachedLookup(ctx, partCl.cache, partitionCacheKey(partitionId), func(ctx context.Context) (*PartitionObject, error) {
+		return partCl.getPartition(ctx, partitionId)
+	})
+}
+
+func (partCl *PartitionClient) getPartition(ctx context.Context, partitionId string) (*PartitionObject, error) {
+	cancelCtx, cancel := partCl.callContext(ctx, "GetPartition")
 	defer cancel()
 
 	request := GetRequest{
 		Id: partitionId,
 	}
 
-	return partCl.client.GetPartition(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "GetPartition", partCl.client.GetPartition, &request)
 }
 
 // NewChildPartition partitions can have children, for example a bank can have multiple branches
@@ -222,7 +400,7 @@ func (partCl *PartitionClient) NewChildPartition(ctx context.Context, tenantId s
 func (partCl *PartitionClient) newPartition(ctx context.Context, tenantId string,
 	parentId string, name string, description string, props map[string]string) (*PartitionObject, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "CreatePartition")
 	defer cancel()
 
 	request := PartitionCreateRequest{
@@ -233,13 +411,17 @@ func (partCl *PartitionClient) newPartition(ctx context.Context, tenantId string
 		Properties:  props,
 	}
 
-	return partCl.client.CreatePartition(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(idempotentContext(cancelCtx), partCl, "CreatePartition", partCl.client.CreatePartition, &request)
 }
 
 func (partCl *PartitionClient) UpdatePartition(ctx context.Context, partitionId string,
 	name string, description string, props map[string]string) (*PartitionObject, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "UpdatePartition")
 	defer cancel()
 
 	request := PartitionUpdateRequest{
@@ -249,70 +431,121 @@ func (partCl *PartitionClient) UpdatePartition(ctx context.Context, partitionId
 		Properties:  props,
 	}
 
-	return partCl.client.UpdatePartition(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	defer partCl.cache.invalidatePartition(partitionId)
+	return invoke(cancelCtx, partCl, "UpdatePartition", partCl.client.UpdatePartition, &request)
 }
 
+// CreatePartitionRole creates a role that, once granted on the partition, also applies to its descendants.
 func (partCl *PartitionClient) CreatePartitionRole(ctx context.Context, partitionId string,
 	name string, props map[string]string) (*PartitionRoleObject, error) {
-
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
-	defer cancel()
-
-	request := PartitionRoleCreateRequest{
+	return partCl.createPartitionRole(ctx, &PartitionRoleCreateRequest{
 		Name:        name,
 		PartitionId: partitionId,
 		Properties:  props,
+	})
+}
+
+// CreateNonInheritablePartitionRole creates a role that only applies on the partition it is granted on,
+// access inherited by descendant partitions does not carry it.
+func (partCl *PartitionClient) CreateNonInheritablePartitionRole(ctx context.Context, partitionId string,
+	name string, props map[string]string) (*PartitionRoleObject, error) {
+	return partCl.createPartitionRole(ctx, &PartitionRoleCreateRequest{
+		Name:           name,
+		PartitionId:    partitionId,
+		Properties:     props,
+		NonInheritable: true,
+	})
+}
+
+func (partCl *PartitionClient) createPartitionRole(
+	ctx context.Context, request *PartitionRoleCreateRequest) (*PartitionRoleObject, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "CreatePartitionRole")
+	defer cancel()
+
+	if err := partCl.validate(request); err != nil {
+		return nil, err
 	}
 
-	return partCl.client.CreatePartitionRole(cancelCtx, &request)
+	return invoke(idempotentContext(cancelCtx), partCl, "CreatePartitionRole", partCl.client.CreatePartitionRole, request)
 }
 
 func (partCl *PartitionClient) RemovePartitionRole(ctx context.Context, partitionRoleId string) (*RemoveResponse, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "RemovePartitionRole")
 	defer cancel()
 
 	request := PartitionRoleRemoveRequest{
 		PartitionRoleId: partitionRoleId,
 	}
 
-	return partCl.client.RemovePartitionRole(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "RemovePartitionRole", partCl.client.RemovePartitionRole, &request)
 }
 
 func (partCl *PartitionClient) ListPartitionRoles(
 	ctx context.Context,
 	partitionId string) (*PartitionRoleListResponse, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "ListPartitionRoles")
 	defer cancel()
 
 	partitionRoleRequest := PartitionRoleListRequest{
 		PartitionId: partitionId,
 	}
 
-	return partCl.client.ListPartitionRoles(cancelCtx, &partitionRoleRequest)
+	if err := partCl.validate(&partitionRoleRequest); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "ListPartitionRoles", partCl.client.ListPartitionRoles, &partitionRoleRequest)
 }
 
 // NewPage a partition has a provision to store custom pages that can be shown to users later.
-// These pages can include signup or customer specified customized pictures
+// These pages can include signup or customer specified customized pictures. The html is served as it is,
+// braces included, use NewTemplatePage for pages referencing partition variables.
 func (partCl *PartitionClient) NewPage(ctx context.Context, partitionId string, name string, html string) (*PageObject, error) {
+	return partCl.newPage(ctx, &PageCreateRequest{
+		Name:        name,
+		Html:        html,
+		PartitionId: partitionId,
+	})
+}
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
-	defer cancel()
-
-	request := PageCreateRequest{
+// NewTemplatePage stores a custom page whose html may reference variables of the partition it is rendered
+// for, see RenderPageTemplate for the syntax.
+func (partCl *PartitionClient) NewTemplatePage(ctx context.Context, partitionId string, name string, html string) (*PageObject, error) {
+	return partCl.newPage(ctx, &PageCreateRequest{
 		Name:        name,
 		Html:        html,
 		PartitionId: partitionId,
+		Template:    true,
+	})
+}
+
+func (partCl *PartitionClient) newPage(ctx context.Context, request *PageCreateRequest) (*PageObject, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "CreatePage")
+	defer cancel()
+
+	if err := partCl.validate(request); err != nil {
+		return nil, err
 	}
 
-	return partCl.client.CreatePage(cancelCtx, &request)
+	return invoke(idempotentContext(cancelCtx), partCl, "CreatePage", partCl.client.CreatePage, request)
 }
 
 // GetPage simple way to quickly pull custom pages accessed by clients of a partition
 func (partCl *PartitionClient) GetPage(ctx context.Context, partitionId string, name string) (*PageObject, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "GetPage")
 	defer cancel()
 
 	request := PageGetRequest{
@@ -320,14 +553,135 @@ func (partCl *PartitionClient) GetPage(ctx context.Context, partitionId string,
 		PartitionId: partitionId,
 	}
 
-	return partCl.client.GetPage(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "GetPage", partCl.client.GetPage, &request)
+}
+
+// RenderPage obtains the html of a page with its variables resolved for the partition, when the partition
+// has no page of that name the page of its closest ancestor is rendered for it instead.
+// The response identifies the page rendered and the partition it belongs to.
+func (partCl *PartitionClient) RenderPage(ctx context.Context, partitionId string, name string) (*PageRenderResponse, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "RenderPage")
+	defer cancel()
+
+	request := PageRenderRequest{
+		PartitionId: partitionId,
+		Name:        name,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "RenderPage", partCl.client.RenderPage, &request)
+}
+
+// RemovePage stops a page from being served for its partition.
+func (partCl *PartitionClient) RemovePage(ctx context.Context, pageId string) (*RemoveResponse, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "RemovePage")
+	defer cancel()
+
+	request := PageRemoveRequest{
+		PageId: pageId,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "RemovePage", partCl.client.RemovePage, &request)
+}
+
+// ListPages obtains the pages of a partition ordered by name.
+func (partCl *PartitionClient) ListPages(ctx context.Context, partitionId string) ([]*PageObject, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "ListPages")
+	defer cancel()
+
+	request := PageListRequest{
+		PartitionId: partitionId,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	response, err := invoke(cancelCtx, partCl, "ListPages", partCl.client.ListPages, &request)
+	if err != nil {
+		return nil, err
+	}
+	return response.GetPage(), nil
+}
+
+// UpdatePage changes the name and html of a page in place so it keeps being served while it is edited,
+// the html replaced is kept as a prior version that RollbackPage can restore.
+func (partCl *PartitionClient) UpdatePage(ctx context.Context, pageId string, name string, html string) (*PageObject, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "UpdatePage")
+	defer cancel()
+
+	request := PageUpdateRequest{
+		PageId: pageId,
+		Name:   name,
+		Html:   html,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "UpdatePage", partCl.client.UpdatePage, &request)
+}
+
+// ListPageVersions obtains the versions kept of the html of a page from the newest, which is the current one.
+func (partCl *PartitionClient) ListPageVersions(ctx context.Context, pageId string) ([]*PageVersion, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "ListPageVersions")
+	defer cancel()
+
+	request := PageVersionListRequest{
+		PageId: pageId,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	response, err := invoke(cancelCtx, partCl, "ListPageVersions", partCl.client.ListPageVersions, &request)
+	if err != nil {
+		return nil, err
+	}
+	return response.GetVersion(), nil
+}
+
+// RollbackPage restores the html of a prior version of a page, the restored html is recorded as a new version.
+func (partCl *PartitionClient) RollbackPage(ctx context.Context, pageId string, version uint32) (*PageObject, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "RollbackPage")
+	defer cancel()
+
+	request := PageRollbackRequest{
+		PageId:  pageId,
+		Version: version,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "RollbackPage", partCl.client.RollbackPage, &request)
 }
 
 func (partCl *PartitionClient) CreateAccess(
 	ctx context.Context,
 	partitionId string, profileId string) (*AccessObject, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "CreateAccess")
 	defer cancel()
 
 	request := AccessCreateRequest{
@@ -335,39 +689,63 @@ func (partCl *PartitionClient) CreateAccess(
 		PartitionId: partitionId,
 	}
 
-	return partCl.client.CreateAccess(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	defer partCl.cache.invalidateAccess(partitionId, profileId)
+	return invoke(idempotentContext(cancelCtx), partCl, "CreateAccess", partCl.client.CreateAccess, &request)
 }
 
 func (partCl *PartitionClient) RemoveAccess(ctx context.Context, accessId string) (*RemoveResponse, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "RemoveAccess")
 	defer cancel()
 
 	request := AccessRemoveRequest{
 		AccessId: accessId,
 	}
 
-	return partCl.client.RemoveAccess(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	defer partCl.cache.invalidateAccessId(accessId)
+	return invoke(cancelCtx, partCl, "RemoveAccess", partCl.client.RemoveAccess, &request)
 }
 
 func (partCl *PartitionClient) GetAccessById(ctx context.Context, accessId string) (*AccessObject, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "GetAccess")
 	defer cancel()
 
 	request := AccessGetRequest{
 		AccessId: accessId,
 	}
 
-	return partCl.client.GetAccess(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "GetAccess", partCl.client.GetAccess, &request)
 }
 
+// GetAccess obtains the access a profile has to a partition.
 func (partCl *PartitionClient) GetAccess(
 	ctx context.Context,
 	partitionId string,
 	profileId string) (*AccessObject, error) {
+	return cachedLookup(ctx, partCl.cache, accessCacheKey(partitionId, profileId), func(ctx context.Context) (*AccessObject, error) {
+		return partCl.getAccess(ctx, partitionId, profileId)
+	})
+}
+
+func (partCl *PartitionClient) getAccess(
+	ctx context.Context,
+	partitionId string,
+	profileId string) (*AccessObject, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "GetAccess")
 	defer cancel()
 
 	request := AccessGetRequest{
@@ -375,7 +753,44 @@ func (partCl *PartitionClient) GetAccess(
 		PartitionId: partitionId,
 	}
 
-	return partCl.client.GetAccess(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "GetAccess", partCl.client.GetAccess, &request)
+}
+
+// GetEffectiveAccess obtains the access a profile has to a partition, either granted on the partition itself
+// or inherited from the closest ancestor it was granted on. Inherited access is marked as such and embeds
+// the ancestor partition it was granted on.
+func (partCl *PartitionClient) GetEffectiveAccess(
+	ctx context.Context,
+	partitionId string,
+	profileId string) (*AccessObject, error) {
+	return cachedLookup(ctx, partCl.cache, effectiveAccessCacheKey(partitionId, profileId), func(ctx context.Context) (*AccessObject, error) {
+		return partCl.getEffectiveAccess(ctx, partitionId, profileId)
+	})
+}
+
+func (partCl *PartitionClient) getEffectiveAccess(
+	ctx context.Context,
+	partitionId string,
+	profileId string) (*AccessObject, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "GetAccess")
+	defer cancel()
+
+	request := AccessGetRequest{
+		ProfileId:        profileId,
+		PartitionId:      partitionId,
+		IncludeInherited: true,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "GetAccess", partCl.client.GetAccess, &request)
 }
 
 func (partCl *PartitionClient) CreateAccessRole(
@@ -383,7 +798,7 @@ func (partCl *PartitionClient) CreateAccessRole(
 	accessId string,
 	partitionRoleId string) (*AccessRoleObject, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "CreateAccessRole")
 	defer cancel()
 
 	request := AccessRoleCreateRequest{
@@ -391,29 +806,41 @@ func (partCl *PartitionClient) CreateAccessRole(
 		PartitionRoleId: partitionRoleId,
 	}
 
-	return partCl.client.CreateAccessRole(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(idempotentContext(cancelCtx), partCl, "CreateAccessRole", partCl.client.CreateAccessRole, &request)
 }
 
 func (partCl *PartitionClient) RemoveAccessRole(ctx context.Context, accessRoleId string) (*RemoveResponse, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "RemoveAccessRole")
 	defer cancel()
 
 	request := AccessRoleRemoveRequest{
 		AccessRoleId: accessRoleId,
 	}
 
-	return partCl.client.RemoveAccessRole(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "RemoveAccessRole", partCl.client.RemoveAccessRole, &request)
 }
 
 func (partCl *PartitionClient) ListAccess(ctx context.Context, accessId string) (*AccessRoleListResponse, error) {
 
-	cancelCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	cancelCtx, cancel := partCl.callContext(ctx, "ListAccessRoles")
 	defer cancel()
 
 	request := AccessRoleListRequest{
 		AccessId: accessId,
 	}
 
-	return partCl.client.ListAccessRoles(cancelCtx, &request)
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	return invoke(cancelCtx, partCl, "ListAccessRoles", partCl.client.ListAccessRoles, &request)
 }
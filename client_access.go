@@ -0,0 +1,177 @@
+package partitionv1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antinvestor/apis/common"
+)
+
+// CanAccess reports whether a profile has active access to a partition holding the named role, an empty
+// role only checks for active access. With includeAncestors access granted on an ancestor partition counts too.
+func (partCl *PartitionClient) CanAccess(ctx context.Context, partitionId string, profileId string,
+	role string, includeAncestors bool) (bool, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "CheckAccess")
+	defer cancel()
+
+	request := AccessCheckRequest{
+		PartitionId:      partitionId,
+		ProfileId:        profileId,
+		Role:             role,
+		IncludeAncestors: includeAncestors,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return false, err
+	}
+
+	response, err := invoke(cancelCtx, partCl, "CheckAccess", partCl.client.CheckAccess, &request)
+	if err != nil {
+		return false, err
+	}
+	return response.GetAllowed(), nil
+}
+
+// CanAccessBatch answers several access checks with a single call, the outcomes are in the order of checks.
+func (partCl *PartitionClient) CanAccessBatch(ctx context.Context, checks []*AccessCheckRequest) ([]bool, error) {
+	cancelCtx, cancel := partCl.callContext(ctx, "CheckAccessBatch")
+	defer cancel()
+
+	request := AccessCheckBatchRequest{
+		Checks: checks,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	response, err := invoke(cancelCtx, partCl, "CheckAccessBatch", partCl.client.CheckAccessBatch, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.GetResults()) != len(checks) {
+		return nil, fmt.Errorf("check access batch : %d results returned for %d checks",
+			len(response.GetResults()), len(checks))
+	}
+
+	allowed := make([]bool, len(checks))
+	for i, result := range response.GetResults() {
+		allowed[i] = result.GetAllowed()
+	}
+	return allowed, nil
+}
+
+// ListAccessByProfile gets a page of the access a profile holds across partitions using cursor based paging,
+// each with the partition it grants access to and, when includeRoles is set, the roles granted with it.
+// An empty tenantId lists access in every tenant and no states lists access in any state. An empty pageToken
+// starts from the first page, the returned token continues after the access returned and is empty once the
+// last page was reached.
+func (partCl *PartitionClient) ListAccessByProfile(
+	ctx context.Context,
+	profileId string,
+	tenantId string,
+	states []common.STATE,
+	includeRoles bool,
+	count uint,
+	pageToken string) ([]*AccessObject, string, error) {
+
+	request := AccessListByProfileRequest{
+		ProfileId:    profileId,
+		TenantId:     tenantId,
+		States:       states,
+		IncludeRoles: includeRoles,
+		Search: &SearchRequest{
+			Count:     uint32(count),
+			PageToken: pageToken,
+		},
+	}
+
+	accessStream, err := partCl.SearchAccessByProfile(ctx, &request)
+	if err != nil {
+		return nil, "", err
+	}
+	defer accessStream.Close()
+
+	var accessList []*AccessObject
+	for accessStream.Next() {
+		accessList = append(accessList, accessStream.Access())
+	}
+	return accessList, accessStream.NextPageToken(), accessStream.Err()
+}
+
+// SearchAccessByProfile streams the access of a profile selected by the supplied request,
+// the iterator must be drained or closed.
+func (partCl *PartitionClient) SearchAccessByProfile(
+	ctx context.Context, request *AccessListByProfileRequest) (*AccessIterator, error) {
+	if err := partCl.validate(request); err != nil {
+		return nil, err
+	}
+
+	cancelCtx, cancel := partCl.callContext(ctx, "ListAccessByProfile")
+
+	accessStream, err := partCl.client.ListAccessByProfile(cancelCtx, request)
+	if err != nil {
+		cancel()
+		return nil, toError(err)
+	}
+
+	return newAccessIterator(accessStream, cancel), nil
+}
+
+// ListPartitionAccess gets a page of the access granted on a partition using cursor based paging, each with the
+// roles granted with it. A non empty role lists only the access holding that role and no states lists access
+// in any state, sort orders the access by creation time. An empty pageToken starts from the first page, the
+// returned token continues after the access returned and is empty once the last page was reached.
+func (partCl *PartitionClient) ListPartitionAccess(
+	ctx context.Context,
+	partitionId string,
+	role string,
+	states []common.STATE,
+	sort AccessSort,
+	count uint,
+	pageToken string) ([]*AccessObject, string, error) {
+
+	request := PartitionAccessListRequest{
+		PartitionId: partitionId,
+		Role:        role,
+		States:      states,
+		Sort:        sort,
+		Search: &SearchRequest{
+			Count:     uint32(count),
+			PageToken: pageToken,
+		},
+	}
+
+	accessStream, err := partCl.SearchPartitionAccess(ctx, &request)
+	if err != nil {
+		return nil, "", err
+	}
+	defer accessStream.Close()
+
+	var accessList []*AccessObject
+	for accessStream.Next() {
+		accessList = append(accessList, accessStream.Access())
+	}
+	return accessList, accessStream.NextPageToken(), accessStream.Err()
+}
+
+// SearchPartitionAccess streams the access granted on a partition selected by the supplied request,
+// the iterator must be drained or closed.
+func (partCl *PartitionClient) SearchPartitionAccess(
+	ctx context.Context, request *PartitionAccessListRequest) (*AccessIterator, error) {
+	if err := partCl.validate(request); err != nil {
+		return nil, err
+	}
+
+	cancelCtx, cancel := partCl.callContext(ctx, "ListPartitionAccess")
+
+	accessStream, err := partCl.client.ListPartitionAccess(cancelCtx, request)
+	if err != nil {
+		cancel()
+		return nil, toError(err)
+	}
+
+	return newAccessIterator(accessStream, cancel), nil
+}
@@ -0,0 +1,378 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/antinvestor/apis/common"
+	"github.com/golang/mock/gomock"
+)
+
+func TestCanAccess(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	bank, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	branch, err := partCl.NewChildPartition(ctx, tenant.GetTenantId(), bank.GetPartitionId(),
+		"branch", "a branch of the bank", nil)
+	if err != nil {
+		t.Fatalf("could not create child partition : %v", err)
+	}
+
+	role, err := partCl.CreatePartitionRole(ctx, bank.GetPartitionId(), "admin", nil)
+	if err != nil {
+		t.Fatalf("could not create partition role : %v", err)
+	}
+
+	access, err := partCl.CreateAccess(ctx, bank.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not create access : %v", err)
+	}
+
+	_, err = partCl.CreateAccessRole(ctx, access.GetAccessId(), role.GetPartitionRoleId())
+	if err != nil {
+		t.Fatalf("could not create access role : %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		partitionId      string
+		profileId        string
+		role             string
+		includeAncestors bool
+		allowed          bool
+	}{
+		{"role held on the partition", bank.GetPartitionId(), "profile1", "admin", false, true},
+		{"access without a role", bank.GetPartitionId(), "profile1", "", false, true},
+		{"role not held", bank.GetPartitionId(), "profile1", "auditor", false, false},
+		{"profile without access", bank.GetPartitionId(), "profile2", "admin", false, false},
+		{"role held on an ancestor", branch.GetPartitionId(), "profile1", "admin", true, true},
+		{"ancestors not included", branch.GetPartitionId(), "profile1", "admin", false, false},
+		{"missing partition", "c2f4j7au6s7f91uqnojg", "profile1", "admin", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := partCl.CanAccess(ctx, tt.partitionId, tt.profileId, tt.role, tt.includeAncestors)
+			if err != nil {
+				t.Fatalf("could not check access : %v", err)
+			}
+			if allowed != tt.allowed {
+				t.Errorf("expected allowed to be %v, got %v", tt.allowed, allowed)
+			}
+		})
+	}
+
+	allowed, err := partCl.CanAccessBatch(ctx, []*AccessCheckRequest{
+		{PartitionId: branch.GetPartitionId(), ProfileId: "profile1", Role: "admin", IncludeAncestors: true},
+		{PartitionId: branch.GetPartitionId(), ProfileId: "profile1", Role: "admin"},
+		{PartitionId: bank.GetPartitionId(), ProfileId: "profile2"},
+	})
+	if err != nil {
+		t.Fatalf("could not check access in a batch : %v", err)
+	}
+	if len(allowed) != 3 || !allowed[0] || allowed[1] || allowed[2] {
+		t.Errorf("expected only the first check to be allowed, got %v", allowed)
+	}
+
+	_, err = partCl.CanAccessBatch(ctx, nil)
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("expected an empty batch to be rejected, got %v", err)
+	}
+}
+
+func TestInheritedAccess(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t, WithCache(CacheConfig{}))
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	region, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "region", "a region of the bank", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	branch, err := partCl.NewChildPartition(ctx, tenant.GetTenantId(), region.GetPartitionId(),
+		"branch", "a branch of the region", nil)
+	if err != nil {
+		t.Fatalf("could not create child partition : %v", err)
+	}
+
+	manager, err := partCl.CreatePartitionRole(ctx, region.GetPartitionId(), "manager", nil)
+	if err != nil {
+		t.Fatalf("could not create partition role : %v", err)
+	}
+
+	budget, err := partCl.CreateNonInheritablePartitionRole(ctx, region.GetPartitionId(), "budget owner", nil)
+	if err != nil {
+		t.Fatalf("could not create non inheritable partition role : %v", err)
+	}
+	if !budget.GetNonInheritable() {
+		t.Errorf("expected the budget owner role to be non inheritable")
+	}
+
+	access, err := partCl.CreateAccess(ctx, region.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not create access : %v", err)
+	}
+
+	for _, role := range []*PartitionRoleObject{manager, budget} {
+		if _, err = partCl.CreateAccessRole(ctx, access.GetAccessId(), role.GetPartitionRoleId()); err != nil {
+			t.Fatalf("could not create access role %s : %v", role.GetName(), err)
+		}
+	}
+
+	_, err = partCl.GetAccess(ctx, branch.GetPartitionId(), "profile1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected no direct access to the branch, got %v", err)
+	}
+
+	effective, err := partCl.GetEffectiveAccess(ctx, branch.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not get effective access : %v", err)
+	}
+	if effective.GetAccessId() != access.GetAccessId() || !effective.GetInherited() ||
+		effective.GetPartition().GetPartitionId() != region.GetPartitionId() {
+		t.Errorf("expected the access to be inherited from the region, got %v", effective)
+	}
+
+	effective, err = partCl.GetEffectiveAccess(ctx, region.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not get effective access : %v", err)
+	}
+	if effective.GetInherited() {
+		t.Errorf("expected access granted on the region not to be inherited")
+	}
+
+	for role, expected := range map[string]bool{"manager": true, "budget owner": false} {
+		allowed, err := partCl.CanAccess(ctx, branch.GetPartitionId(), "profile1", role, true)
+		if err != nil {
+			t.Fatalf("could not check access : %v", err)
+		}
+		if allowed != expected {
+			t.Errorf("expected the %s role on the branch to be allowed %v, got %v", role, expected, allowed)
+		}
+	}
+
+	direct, err := partCl.CreateAccess(ctx, branch.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not create access : %v", err)
+	}
+
+	effective, err = partCl.GetEffectiveAccess(ctx, branch.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not get effective access : %v", err)
+	}
+	if effective.GetAccessId() != direct.GetAccessId() || effective.GetInherited() {
+		t.Errorf("expected access granted on the branch to take precedence, got %v", effective)
+	}
+}
+
+func TestListAccessByProfile(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	var tenantIds []string
+	var granted []*AccessObject
+	for _, tenantName := range []string{"bank", "insurer"} {
+		tenant, err := partCl.NewTenant(ctx, tenantName, "a tenant for tests", nil)
+		if err != nil {
+			t.Fatalf("could not create tenant : %v", err)
+		}
+		tenantIds = append(tenantIds, tenant.GetTenantId())
+
+		for i := 0; i < 3; i++ {
+			partition, err := partCl.NewPartition(ctx, tenant.GetTenantId(),
+				fmt.Sprintf("%s branch %d", tenantName, i), "a branch for tests", nil)
+			if err != nil {
+				t.Fatalf("could not create partition : %v", err)
+			}
+
+			access, err := partCl.CreateAccess(ctx, partition.GetPartitionId(), "profile1")
+			if err != nil {
+				t.Fatalf("could not create access : %v", err)
+			}
+			granted = append(granted, access)
+
+			if _, err = partCl.CreateAccess(ctx, partition.GetPartitionId(), "profile2"); err != nil {
+				t.Fatalf("could not create access : %v", err)
+			}
+		}
+	}
+
+	role, err := partCl.CreatePartitionRole(ctx, granted[0].GetPartition().GetPartitionId(), "teller", nil)
+	if err != nil {
+		t.Fatalf("could not create partition role : %v", err)
+	}
+	if _, err = partCl.CreateAccessRole(ctx, granted[0].GetAccessId(), role.GetPartitionRoleId()); err != nil {
+		t.Fatalf("could not create access role : %v", err)
+	}
+
+	firstPage, pageToken, err := partCl.ListAccessByProfile(ctx, "profile1", "", nil, false, 5, "")
+	if err != nil {
+		t.Fatalf("could not list access by profile : %v", err)
+	}
+	if len(firstPage) != 5 || pageToken == "" {
+		t.Fatalf("expected a first page of 5 with a token, got %d with %q", len(firstPage), pageToken)
+	}
+
+	lastPage, pageToken, err := partCl.ListAccessByProfile(ctx, "profile1", "", nil, false, 5, pageToken)
+	if err != nil {
+		t.Fatalf("could not list access by profile : %v", err)
+	}
+	if len(lastPage) != 1 || pageToken != "" {
+		t.Fatalf("expected a last page of 1 without a token, got %d with %q", len(lastPage), pageToken)
+	}
+
+	for i, access := range append(firstPage, lastPage...) {
+		if access.GetAccessId() != granted[i].GetAccessId() || access.GetPartition().GetName() != granted[i].GetPartition().GetName() {
+			t.Errorf("expected access %d to be %v, got %v", i, granted[i], access)
+		}
+		if len(access.GetRoles()) != 0 {
+			t.Errorf("expected no roles when they are not requested, got %v", access.GetRoles())
+		}
+	}
+
+	tenantAccess, _, err := partCl.ListAccessByProfile(ctx, "profile1", tenantIds[0],
+		[]common.STATE{common.STATE_ACTIVE}, true, 0, "")
+	if err != nil {
+		t.Fatalf("could not list access by profile in a tenant : %v", err)
+	}
+	if len(tenantAccess) != 3 {
+		t.Fatalf("expected the 3 partitions of the bank, got %v", tenantAccess)
+	}
+	if roles := tenantAccess[0].GetRoles(); len(roles) != 1 || roles[0].GetRole().GetName() != "teller" {
+		t.Errorf("expected the teller role with the first access, got %v", roles)
+	}
+
+	inactive, _, err := partCl.ListAccessByProfile(ctx, "profile1", "", []common.STATE{common.STATE_INACTIVE}, false, 0, "")
+	if err != nil {
+		t.Fatalf("could not list inactive access by profile : %v", err)
+	}
+	if len(inactive) != 0 {
+		t.Errorf("expected no inactive access, got %v", inactive)
+	}
+
+	_, _, err = partCl.ListAccessByProfile(ctx, "", "", nil, false, 0, "")
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("expected a missing profile to be rejected, got %v", err)
+	}
+}
+
+func TestListPartitionAccess(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	partition, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	auditor, err := partCl.CreatePartitionRole(ctx, partition.GetPartitionId(), "auditor", nil)
+	if err != nil {
+		t.Fatalf("could not create partition role : %v", err)
+	}
+
+	var granted []*AccessObject
+	for i := 0; i < 7; i++ {
+		access, err := partCl.CreateAccess(ctx, partition.GetPartitionId(), fmt.Sprintf("profile%d", i))
+		if err != nil {
+			t.Fatalf("could not create access : %v", err)
+		}
+		granted = append(granted, access)
+
+		if i%3 == 0 {
+			if _, err = partCl.CreateAccessRole(ctx, access.GetAccessId(), auditor.GetPartitionRoleId()); err != nil {
+				t.Fatalf("could not create access role : %v", err)
+			}
+		}
+	}
+
+	listAll := func(sort AccessSort) []*AccessObject {
+		var members []*AccessObject
+		pageToken := ""
+		for {
+			page, nextPageToken, err := partCl.ListPartitionAccess(ctx, partition.GetPartitionId(), "", nil, sort, 5, pageToken)
+			if err != nil {
+				t.Fatalf("could not list partition access : %v", err)
+			}
+			members = append(members, page...)
+			if nextPageToken == "" {
+				return members
+			}
+			pageToken = nextPageToken
+		}
+	}
+
+	oldestFirst := listAll(AccessSort_ACCESS_SORT_CREATED_ASC)
+	newestFirst := listAll(AccessSort_ACCESS_SORT_CREATED_DESC)
+	if len(oldestFirst) != len(granted) || len(newestFirst) != len(granted) {
+		t.Fatalf("expected all %d members both ways, got %d and %d", len(granted), len(oldestFirst), len(newestFirst))
+	}
+
+	for i, access := range granted {
+		if oldestFirst[i].GetAccessId() != access.GetAccessId() {
+			t.Errorf("expected member %d oldest first to be %s, got %s", i, access.GetAccessId(), oldestFirst[i].GetAccessId())
+		}
+		if newestFirst[len(granted)-1-i].GetAccessId() != access.GetAccessId() {
+			t.Errorf("expected member %d newest first to be %s, got %s",
+				len(granted)-1-i, access.GetAccessId(), newestFirst[len(granted)-1-i].GetAccessId())
+		}
+	}
+
+	if roles := oldestFirst[0].GetRoles(); len(roles) != 1 || roles[0].GetRole().GetName() != "auditor" {
+		t.Errorf("expected the auditor role with the first member, got %v", roles)
+	}
+
+	auditors, _, err := partCl.ListPartitionAccess(ctx, partition.GetPartitionId(), "auditor",
+		[]common.STATE{common.STATE_ACTIVE}, AccessSort_ACCESS_SORT_CREATED_ASC, 0, "")
+	if err != nil {
+		t.Fatalf("could not list auditors : %v", err)
+	}
+	if len(auditors) != 3 || auditors[1].GetProfileId() != "profile3" {
+		t.Errorf("expected the 3 auditors, got %v", auditors)
+	}
+
+	_, _, err = partCl.ListPartitionAccess(ctx, "c2f4j7au6s7f91uqnojg", "", nil, AccessSort_ACCESS_SORT_CREATED_ASC, 0, "")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a missing partition to be reported, got %v", err)
+	}
+}
+
+func TestCanAccessBatchResultCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl)
+
+	mockCl.EXPECT().CheckAccessBatch(gomock.Any(), gomock.Any()).
+		Return(&AccessCheckBatchResponse{Results: []*AccessCheckResponse{{Allowed: true}}}, nil)
+
+	allowed, err := partCl.CanAccessBatch(context.Background(), []*AccessCheckRequest{
+		{PartitionId: "partition1", ProfileId: "profile1"},
+		{PartitionId: "partition2", ProfileId: "profile1"},
+	})
+	if err == nil {
+		t.Errorf("expected unanswered checks to fail the batch, got %v", allowed)
+	}
+}
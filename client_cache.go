@@ -0,0 +1,353 @@
+package partitionv1
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultCacheTTL           = time.Minute
+	defaultCacheMaxEntries    = 10000
+	defaultCacheLookupTimeout = time.Second * 30
+)
+
+// CacheConfig bounds the read-through cache enabled with WithCache.
+type CacheConfig struct {
+	// TTL is how long a partition or access is served from the cache, defaults to a minute.
+	TTL time.Duration
+	// NotFoundTTL is how long a lookup that found nothing is remembered, zero disables negative caching.
+	NotFoundTTL time.Duration
+	// MaxEntries bounds the number of lookups kept, the least recently used are evicted first.
+	// Defaults to 10000.
+	MaxEntries int
+	// LookupTimeout bounds a call to the service shared by concurrent lookups, whatever the unary timeout of
+	// the client. Defaults to 30 seconds.
+	LookupTimeout time.Duration
+	// ForwardMetadata names the outgoing metadata keys, e.g. a tenant hint, sent on with a shared lookup.
+	// Only callers with the same values for them share a call to the service, other metadata of the callers
+	// is not forwarded. Cached results are served to every caller whatever their metadata.
+	ForwardMetadata []string
+}
+
+// lookupCache keeps the results of GetPartition, GetAccess and GetEffectiveAccess lookups. Concurrent misses for the same
+// key share a single call to the service.
+type lookupCache struct {
+	config CacheConfig
+	group  singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	// accessKeys maps access ids to the key their access is cached under.
+	accessKeys map[string]string
+	// generation changes on every invalidation, lookups started before it are not stored.
+	generation uint64
+	// flights holds the singleflight keys of the lookups in flight for a cache key, they differ from it
+	// when callers forward metadata.
+	flights map[string]map[string]struct{}
+}
+
+type cacheEntry struct {
+	key     string
+	value   proto.Message
+	err     error
+	expires time.Time
+}
+
+func newLookupCache(config CacheConfig) *lookupCache {
+	if config.TTL <= 0 {
+		config.TTL = defaultCacheTTL
+	}
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = defaultCacheMaxEntries
+	}
+	if config.LookupTimeout <= 0 {
+		config.LookupTimeout = defaultCacheLookupTimeout
+	}
+
+	return &lookupCache{
+		config:     config,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+		accessKeys: map[string]string{},
+		flights:    map[string]map[string]struct{}{},
+	}
+}
+
+func partitionCacheKey(partitionId string) string {
+	return "partition/" + partitionId
+}
+
+func accessCacheKey(partitionId string, profileId string) string {
+	return "access/" + partitionId + "/" + profileId
+}
+
+// effectiveAccessCacheKey is the key of an access looked up including the access inherited from
+// ancestors, any change to access or partitions may affect these so they are dropped on every invalidation.
+func effectiveAccessCacheKey(partitionId string, profileId string) string {
+	return "effective/" + partitionId + "/" + profileId
+}
+
+func isEffectiveAccessCacheKey(key string) bool {
+	return strings.HasPrefix(key, "effective/")
+}
+
+// cachedLookup serves key from the cache when present, otherwise load is called once for all concurrent
+// callers missing the same key and its result stored. Without a cache load is called directly with ctx.
+//
+// A shared load outlives the caller that started it, so it runs on sharedLookupContext(ctx) and is bounded
+// by the client timeouts and the LookupTimeout of the cache instead. Each caller stops waiting for it once its
+// own ctx is done.
+func cachedLookup[T proto.Message](
+	ctx context.Context,
+	cache *lookupCache,
+	key string,
+	load func(context.Context) (T, error)) (T, error) {
+
+	var empty T
+	if cache == nil {
+		return load(ctx)
+	}
+
+	if value, err, ok := cache.get(key); ok {
+		if err != nil {
+			return empty, err
+		}
+		return proto.Clone(value).(T), nil
+	}
+
+	generation := cache.currentGeneration()
+	flight := cache.flightKey(ctx, key)
+	results := cache.group.DoChan(flight, func() (interface{}, error) {
+		cache.beginFlight(key, flight)
+		defer cache.endFlight(key, flight)
+
+		shared, cancel := context.WithTimeout(sharedLookupContext(ctx, cache.config.ForwardMetadata),
+			cache.config.LookupTimeout)
+		defer cancel()
+
+		obj, err := load(shared)
+		cache.store(key, generation, obj, err)
+		return obj, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return empty, toError(status.FromContextError(ctx.Err()).Err())
+	case result := <-results:
+		if result.Err != nil {
+			return empty, result.Err
+		}
+		return proto.Clone(result.Val.(T)).(T), nil
+	}
+}
+
+// sharedLookupContext keeps the values of ctx, such as tracing spans, for a lookup shared between callers
+// but neither its cancellation and deadline nor the request metadata of the caller that happened to start it,
+// apart from the forwarded keys every caller sharing the lookup agrees on.
+func sharedLookupContext(ctx context.Context, forward []string) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	shared := metadata.MD{}
+	for _, key := range forward {
+		if values := md.Get(key); len(values) > 0 {
+			shared.Set(key, values...)
+		}
+	}
+	return metadata.NewOutgoingContext(detachedContext{parent: ctx}, shared)
+}
+
+// flightKey is the singleflight key of a lookup of key, callers only share a lookup when they forward the same
+// metadata.
+func (cache *lookupCache) flightKey(ctx context.Context, key string) string {
+	if len(cache.config.ForwardMetadata) == 0 {
+		return key
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	var flight strings.Builder
+	flight.WriteString(key)
+	for _, name := range cache.config.ForwardMetadata {
+		for _, value := range md.Get(name) {
+			flight.WriteString("\x00" + strings.ToLower(name) + "=" + value)
+		}
+	}
+	return flight.String()
+}
+
+func (cache *lookupCache) beginFlight(key string, flight string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.flights[key] == nil {
+		cache.flights[key] = map[string]struct{}{}
+	}
+	cache.flights[key][flight] = struct{}{}
+}
+
+func (cache *lookupCache) endFlight(key string, flight string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	delete(cache.flights[key], flight)
+	if len(cache.flights[key]) == 0 {
+		delete(cache.flights, key)
+	}
+}
+
+// detachedContext exposes the values of its parent without its cancellation.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)           { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}                 { return nil }
+func (detachedContext) Err() error                            { return nil }
+func (ctx detachedContext) Value(key interface{}) interface{} { return ctx.parent.Value(key) }
+
+func (cache *lookupCache) get(key string) (proto.Message, error, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	if time.Now().After(entry.expires) {
+		cache.remove(element)
+		return nil, nil, false
+	}
+
+	cache.lru.MoveToFront(element)
+	return entry.value, entry.err, true
+}
+
+func (cache *lookupCache) currentGeneration() uint64 {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache.generation
+}
+
+// store keeps the result of a lookup started at generation, not found errors are kept for NotFoundTTL
+// and other errors are not kept at all.
+func (cache *lookupCache) store(key string, generation uint64, value proto.Message, err error) {
+	ttl := cache.config.TTL
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) || cache.config.NotFoundTTL <= 0 {
+			return
+		}
+		ttl = cache.config.NotFoundTTL
+		value = nil
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if generation != cache.generation {
+		return
+	}
+
+	if element, ok := cache.entries[key]; ok {
+		cache.remove(element)
+	}
+
+	entry := &cacheEntry{key: key, value: value, err: err, expires: time.Now().Add(ttl)}
+	cache.entries[key] = cache.lru.PushFront(entry)
+	if access, ok := value.(*AccessObject); ok && !isEffectiveAccessCacheKey(key) {
+		cache.accessKeys[access.GetAccessId()] = key
+	}
+
+	for cache.lru.Len() > cache.config.MaxEntries {
+		cache.remove(cache.lru.Back())
+	}
+}
+
+// remove drops a cached entry, the caller must hold the lock.
+func (cache *lookupCache) remove(element *list.Element) {
+	entry := cache.lru.Remove(element).(*cacheEntry)
+	delete(cache.entries, entry.key)
+	if access, ok := entry.value.(*AccessObject); ok && cache.accessKeys[access.GetAccessId()] == entry.key {
+		delete(cache.accessKeys, access.GetAccessId())
+	}
+}
+
+// invalidate drops the entries whose key matches, in flight lookups are not stored once they complete.
+func (cache *lookupCache) invalidate(match func(key string) bool) {
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.generation++
+	for key, element := range cache.entries {
+		if match(key) {
+			cache.remove(element)
+			cache.forgetLocked(key)
+		}
+	}
+}
+
+// invalidatePartition drops a partition together with the access to it, which embeds the partition.
+func (cache *lookupCache) invalidatePartition(partitionId string) {
+	cache.invalidate(func(key string) bool {
+		return key == partitionCacheKey(partitionId) || strings.HasPrefix(key, accessCacheKey(partitionId, "")) ||
+			isEffectiveAccessCacheKey(key)
+	})
+	cache.forget(partitionCacheKey(partitionId))
+}
+
+func (cache *lookupCache) invalidateAccess(partitionId string, profileId string) {
+	cache.invalidate(func(key string) bool {
+		return key == accessCacheKey(partitionId, profileId) || isEffectiveAccessCacheKey(key)
+	})
+	cache.forget(accessCacheKey(partitionId, profileId))
+}
+
+func (cache *lookupCache) invalidateAccessId(accessId string) {
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	accessKey := cache.accessKeys[accessId]
+	cache.mu.Unlock()
+
+	cache.invalidate(func(key string) bool {
+		return key == accessKey || isEffectiveAccessCacheKey(key)
+	})
+}
+
+// forget stops callers from joining a lookup of key that is already in flight.
+func (cache *lookupCache) forget(key string) {
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.forgetLocked(key)
+}
+
+// forgetLocked is forget for callers holding the lock.
+func (cache *lookupCache) forgetLocked(key string) {
+	cache.group.Forget(key)
+	for flight := range cache.flights[key] {
+		cache.group.Forget(flight)
+	}
+}
+
+func (cache *lookupCache) purge() {
+	cache.invalidate(func(string) bool { return true })
+}
@@ -0,0 +1,247 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCachedPartitionLookups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithCache(CacheConfig{TTL: time.Minute}))
+
+	mockCl.EXPECT().GetPartition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionObject, error) {
+			time.Sleep(time.Millisecond * 50)
+			return &PartitionObject{PartitionId: in.GetId(), Name: "bank"}, nil
+		}).Times(1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			partition, err := partCl.GetPartition(context.Background(), "partition1")
+			if err != nil || partition.GetName() != "bank" {
+				t.Errorf("could not get partition : %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	partition, err := partCl.GetPartition(context.Background(), "partition1")
+	if err != nil {
+		t.Fatalf("could not get cached partition : %v", err)
+	}
+	partition.Name = "changed by the caller"
+
+	mockCl.EXPECT().UpdatePartition(gomock.Any(), gomock.Any()).Return(&PartitionObject{PartitionId: "partition1", Name: "bank two"}, nil)
+	mockCl.EXPECT().GetPartition(gomock.Any(), gomock.Any()).Return(&PartitionObject{PartitionId: "partition1", Name: "bank two"}, nil)
+
+	partition, err = partCl.GetPartition(context.Background(), "partition1")
+	if err != nil || partition.GetName() != "bank" {
+		t.Errorf("expected the cached partition to be unaffected by callers, got %v %v", partition, err)
+	}
+
+	_, err = partCl.UpdatePartition(context.Background(), "partition1", "bank two", "the renamed bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not update partition : %v", err)
+	}
+
+	partition, err = partCl.GetPartition(context.Background(), "partition1")
+	if err != nil || partition.GetName() != "bank two" {
+		t.Errorf("expected the update to invalidate the cached partition, got %v %v", partition, err)
+	}
+}
+
+func TestCachedAccessLookups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl,
+		WithCache(CacheConfig{TTL: time.Minute, NotFoundTTL: time.Minute}))
+
+	notFound := status.Error(codes.NotFound, "profile profile1 has no access to partition partition1")
+	mockCl.EXPECT().GetAccess(gomock.Any(), gomock.Any()).Return(nil, notFound).Times(1)
+
+	for i := 0; i < 2; i++ {
+		_, err := partCl.GetAccess(context.Background(), "partition1", "profile1")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	}
+
+	access := &AccessObject{AccessId: "access1", ProfileId: "profile1", Partition: &PartitionObject{PartitionId: "partition1"}}
+	mockCl.EXPECT().CreateAccess(gomock.Any(), gomock.Any()).Return(access, nil)
+	mockCl.EXPECT().GetAccess(gomock.Any(), gomock.Any()).Return(access, nil).Times(1)
+
+	_, err := partCl.CreateAccess(context.Background(), "partition1", "profile1")
+	if err != nil {
+		t.Fatalf("could not create access : %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		found, err := partCl.GetAccess(context.Background(), "partition1", "profile1")
+		if err != nil || found.GetAccessId() != "access1" {
+			t.Errorf("expected the created access once the not found entry was dropped, got %v %v", found, err)
+		}
+	}
+
+	mockCl.EXPECT().RemoveAccess(gomock.Any(), gomock.Any()).Return(&RemoveResponse{Succeeded: true}, nil)
+	mockCl.EXPECT().GetAccess(gomock.Any(), gomock.Any()).Return(nil, notFound)
+
+	_, err = partCl.RemoveAccess(context.Background(), "access1")
+	if err != nil {
+		t.Fatalf("could not remove access : %v", err)
+	}
+
+	_, err = partCl.GetAccess(context.Background(), "partition1", "profile1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the removal to invalidate the cached access, got %v", err)
+	}
+}
+
+func TestCacheBounds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl,
+		WithCache(CacheConfig{TTL: time.Millisecond * 50, MaxEntries: 2}))
+
+	calls := map[string]int{}
+	mockCl.EXPECT().GetPartition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionObject, error) {
+			calls[in.GetId()]++
+			return &PartitionObject{PartitionId: in.GetId()}, nil
+		}).AnyTimes()
+
+	for _, id := range []string{"partition1", "partition2", "partition1", "partition3", "partition1", "partition2"} {
+		if _, err := partCl.GetPartition(context.Background(), id); err != nil {
+			t.Fatalf("could not get partition : %v", err)
+		}
+	}
+	if calls["partition1"] != 1 || calls["partition2"] != 2 || calls["partition3"] != 1 {
+		t.Errorf("expected the least recently used partition to be evicted, got calls %v", calls)
+	}
+
+	time.Sleep(time.Millisecond * 60)
+	if _, err := partCl.GetPartition(context.Background(), "partition1"); err != nil {
+		t.Fatalf("could not get partition : %v", err)
+	}
+	if calls["partition1"] != 2 {
+		t.Errorf("expected an expired partition to be fetched again, got %d calls", calls["partition1"])
+	}
+}
+
+func TestSharedLookupKeepsCallersApart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithCache(CacheConfig{TTL: time.Minute}))
+
+	started := make(chan struct{})
+	var requestIds []string
+	mockCl.EXPECT().GetPartition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionObject, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			requestIds = md.Get("x-request-id")
+			close(started)
+
+			select {
+			case <-ctx.Done():
+				return nil, status.FromContextError(ctx.Err()).Err()
+			case <-time.After(time.Millisecond * 60):
+			}
+			return &PartitionObject{PartitionId: in.GetId(), Name: "bank"}, nil
+		}).Times(1)
+
+	impatient, cancel := context.WithTimeout(metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "first"),
+		time.Millisecond*20)
+	defer cancel()
+
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := partCl.GetPartition(impatient, "partition1")
+		firstErr <- err
+	}()
+	<-started
+
+	patient, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	partition, err := partCl.GetPartition(patient, "partition1")
+	if err != nil || partition.GetName() != "bank" {
+		t.Errorf("expected the caller joining the lookup to get the partition, got %v %v", partition, err)
+	}
+
+	if err := <-firstErr; !errors.Is(err, ErrDeadlineExceeded) {
+		t.Errorf("expected the caller with the short deadline to give up on its own, got %v", err)
+	}
+	if len(requestIds) != 0 {
+		t.Errorf("expected the shared lookup not to carry the request metadata of a caller, got %v", requestIds)
+	}
+}
+
+func TestSharedLookupForwardsMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl,
+		WithCache(CacheConfig{TTL: time.Minute, ForwardMetadata: []string{"x-tenant-hint"}}))
+
+	var tenantHints, requestIds []string
+	mockCl.EXPECT().GetPartition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionObject, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			tenantHints = md.Get("x-tenant-hint")
+			requestIds = md.Get("x-request-id")
+			return &PartitionObject{PartitionId: in.GetId(), Name: "bank"}, nil
+		}).Times(1)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-tenant-hint", "bank", "x-request-id", "first")
+	if _, err := partCl.GetPartition(ctx, "partition1"); err != nil {
+		t.Fatalf("could not get partition : %v", err)
+	}
+
+	if len(tenantHints) != 1 || tenantHints[0] != "bank" {
+		t.Errorf("expected the tenant hint to be forwarded, got %v", tenantHints)
+	}
+	if len(requestIds) != 0 {
+		t.Errorf("expected metadata not listed to be left out, got %v", requestIds)
+	}
+}
+
+func TestSharedLookupTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithUnaryTimeout(0),
+		WithCache(CacheConfig{TTL: time.Minute, LookupTimeout: time.Millisecond * 20}))
+
+	mockCl.EXPECT().GetPartition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionObject, error) {
+			<-ctx.Done()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}).Times(1)
+
+	_, err := partCl.GetPartition(context.Background(), "partition1")
+	if !errors.Is(err, ErrDeadlineExceeded) {
+		t.Errorf("expected the shared lookup to be bounded with unary calls unbounded, got %v", err)
+	}
+}
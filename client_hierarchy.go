@@ -0,0 +1,149 @@
+package partitionv1
+
+import (
+	"context"
+)
+
+// PartitionNode is a partition together with the partitions nested under it.
+type PartitionNode struct {
+	Partition *PartitionObject
+	Children  []*PartitionNode
+}
+
+// Walk visits the node and all its descendants depth first, stopping at the first error returned by fn.
+func (node *PartitionNode) Walk(fn func(node *PartitionNode, depth int) error) error {
+	return node.walk(fn, 0)
+}
+
+func (node *PartitionNode) walk(fn func(node *PartitionNode, depth int) error, depth int) error {
+	if err := fn(node, depth); err != nil {
+		return err
+	}
+
+	for _, child := range node.Children {
+		if err := child.walk(fn, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MovePartition places a partition under a new parent within its tenant, an empty parentId makes it a root partition.
+// When includeSubtree is false the partition's children are reattached to its previous parent.
+// Access and roles granted on the partition are preserved.
+func (partCl *PartitionClient) MovePartition(ctx context.Context, partitionId string,
+	parentId string, includeSubtree bool) (*PartitionObject, error) {
+	return partCl.MovePartitionToTenant(ctx, partitionId, "", parentId, includeSubtree)
+}
+
+// MovePartitionToTenant places a partition under a new parent in another tenant, an empty tenantId keeps the current one.
+func (partCl *PartitionClient) MovePartitionToTenant(ctx context.Context, partitionId string,
+	tenantId string, parentId string, includeSubtree bool) (*PartitionObject, error) {
+
+	cancelCtx, cancel := partCl.callContext(ctx, "MovePartition")
+	defer cancel()
+
+	request := PartitionMoveRequest{
+		PartitionId:    partitionId,
+		ParentId:       parentId,
+		TenantId:       tenantId,
+		IncludeSubtree: includeSubtree,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	// a move can change the tenant and ancestry of a whole subtree
+	defer partCl.cache.purge()
+	return invoke(cancelCtx, partCl, "MovePartition", partCl.client.MovePartition, &request)
+}
+
+// ListChildPartitions obtains the partitions directly under the supplied partition.
+func (partCl *PartitionClient) ListChildPartitions(ctx context.Context, partitionId string) ([]*PartitionObject, error) {
+	request := GetRequest{
+		Id: partitionId,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	cancelCtx, cancel := partCl.callContext(ctx, "ListChildPartitions")
+
+	childStream, err := partCl.client.ListChildPartitions(cancelCtx, &request)
+	if err != nil {
+		cancel()
+		return nil, toError(err)
+	}
+
+	children := newPartitionIterator(childStream, cancel)
+	defer children.Close()
+
+	var childList []*PartitionObject
+	for children.Next() {
+		childList = append(childList, children.Partition())
+	}
+	return childList, children.Err()
+}
+
+// GetPartitionAncestors obtains the parent chain of a partition, starting with its parent and ending with the root.
+func (partCl *PartitionClient) GetPartitionAncestors(ctx context.Context, partitionId string) ([]*PartitionObject, error) {
+	cancelCtx, cancel := partCl.callContext(ctx, "GetPartitionAncestors")
+	defer cancel()
+
+	request := GetRequest{
+		Id: partitionId,
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	response, err := invoke(cancelCtx, partCl, "GetPartitionAncestors", partCl.client.GetPartitionAncestors, &request)
+	if err != nil {
+		return nil, err
+	}
+	return response.GetPartition(), nil
+}
+
+// GetPartitionSubtree obtains a partition and its descendants down to depth levels as a tree,
+// a depth of zero fetches the whole subtree.
+func (partCl *PartitionClient) GetPartitionSubtree(ctx context.Context, partitionId string, depth uint) (*PartitionNode, error) {
+	request := PartitionSubtreeRequest{
+		PartitionId: partitionId,
+		Depth:       uint32(depth),
+	}
+
+	if err := partCl.validate(&request); err != nil {
+		return nil, err
+	}
+
+	cancelCtx, cancel := partCl.callContext(ctx, "GetPartitionSubtree")
+
+	subtreeStream, err := partCl.client.GetPartitionSubtree(cancelCtx, &request)
+	if err != nil {
+		cancel()
+		return nil, toError(err)
+	}
+
+	subtree := newPartitionIterator(subtreeStream, cancel)
+	defer subtree.Close()
+
+	var root *PartitionNode
+	nodes := map[string]*PartitionNode{}
+	for subtree.Next() {
+		node := &PartitionNode{Partition: subtree.Partition()}
+		nodes[node.Partition.GetPartitionId()] = node
+
+		if node.Partition.GetPartitionId() == partitionId {
+			root = node
+			continue
+		}
+		if parent, ok := nodes[node.Partition.GetParentId()]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	return root, subtree.Err()
+}
@@ -0,0 +1,164 @@
+package partitionv1
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPartitionHierarchy(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	bank, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	newChild := func(parent *PartitionObject, name string) *PartitionObject {
+		child, err := partCl.NewChildPartition(ctx, tenant.GetTenantId(), parent.GetPartitionId(),
+			name, "a partition under "+parent.GetName(), nil)
+		if err != nil {
+			t.Fatalf("could not create child partition %s : %v", name, err)
+		}
+		return child
+	}
+
+	east := newChild(bank, "east region")
+	west := newChild(bank, "west region")
+	branch := newChild(east, "east branch")
+
+	children, err := partCl.ListChildPartitions(ctx, bank.GetPartitionId())
+	if err != nil {
+		t.Fatalf("could not list child partitions : %v", err)
+	}
+	if len(children) != 2 || children[0].GetPartitionId() != east.GetPartitionId() ||
+		children[1].GetPartitionId() != west.GetPartitionId() {
+		t.Errorf("expected the two regions as children of the bank, got %v", children)
+	}
+
+	ancestors, err := partCl.GetPartitionAncestors(ctx, branch.GetPartitionId())
+	if err != nil {
+		t.Fatalf("could not get partition ancestors : %v", err)
+	}
+	if len(ancestors) != 2 || ancestors[0].GetPartitionId() != east.GetPartitionId() ||
+		ancestors[1].GetPartitionId() != bank.GetPartitionId() {
+		t.Errorf("expected the region then the bank as ancestors, got %v", ancestors)
+	}
+
+	tests := []struct {
+		name  string
+		depth uint
+		nodes int
+	}{
+		{name: "whole subtree", depth: 0, nodes: 4},
+		{name: "direct children", depth: 1, nodes: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := partCl.GetPartitionSubtree(ctx, bank.GetPartitionId(), tt.depth)
+			if err != nil {
+				t.Fatalf("could not get partition subtree : %v", err)
+			}
+			if tree.Partition.GetPartitionId() != bank.GetPartitionId() || len(tree.Children) != 2 {
+				t.Fatalf("expected the bank with two regions at the root, got %v", tree.Partition)
+			}
+
+			nodes := 0
+			_ = tree.Walk(func(node *PartitionNode, depth int) error {
+				nodes++
+				if depth == 2 && node.Partition.GetPartitionId() != branch.GetPartitionId() {
+					t.Errorf("expected the branch at depth 2, got %s", node.Partition.GetName())
+				}
+				return nil
+			})
+			if nodes != tt.nodes {
+				t.Errorf("expected %d partitions in the subtree, got %d", tt.nodes, nodes)
+			}
+		})
+	}
+}
+
+func TestMovePartition(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	bank, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	newChild := func(parent *PartitionObject, name string) *PartitionObject {
+		child, err := partCl.NewChildPartition(ctx, tenant.GetTenantId(), parent.GetPartitionId(),
+			name, "a partition under "+parent.GetName(), nil)
+		if err != nil {
+			t.Fatalf("could not create child partition %s : %v", name, err)
+		}
+		return child
+	}
+
+	east := newChild(bank, "east region")
+	west := newChild(bank, "west region")
+	branch := newChild(east, "city branch")
+	counter := newChild(branch, "branch counter")
+
+	access, err := partCl.CreateAccess(ctx, branch.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not create access : %v", err)
+	}
+
+	_, err = partCl.MovePartition(ctx, east.GetPartitionId(), counter.GetPartitionId(), true)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected failed precondition moving a region under its own descendant, got %v", err)
+	}
+
+	moved, err := partCl.MovePartition(ctx, branch.GetPartitionId(), west.GetPartitionId(), true)
+	if err != nil {
+		t.Fatalf("could not move partition : %v", err)
+	}
+	if moved.GetParentId() != west.GetPartitionId() {
+		t.Errorf("expected the branch under the west region, got parent %s", moved.GetParentId())
+	}
+
+	ancestors, err := partCl.GetPartitionAncestors(ctx, counter.GetPartitionId())
+	if err != nil {
+		t.Fatalf("could not get partition ancestors : %v", err)
+	}
+	if len(ancestors) != 3 || ancestors[1].GetPartitionId() != west.GetPartitionId() {
+		t.Errorf("expected the counter to move along with its branch, got ancestors %v", ancestors)
+	}
+
+	found, err := partCl.GetAccessById(ctx, access.GetAccessId())
+	if err != nil {
+		t.Fatalf("could not get access after move : %v", err)
+	}
+	if found.GetPartition().GetParentId() != west.GetPartitionId() {
+		t.Errorf("expected the access to follow the moved partition, got %v", found.GetPartition())
+	}
+
+	_, err = partCl.MovePartition(ctx, branch.GetPartitionId(), "", false)
+	if err != nil {
+		t.Fatalf("could not move partition to the root : %v", err)
+	}
+
+	counterNow, err := partCl.GetPartition(ctx, counter.GetPartitionId())
+	if err != nil {
+		t.Fatalf("could not get partition : %v", err)
+	}
+	if counterNow.GetParentId() != west.GetPartitionId() {
+		t.Errorf("expected the counter to be reattached to the west region, got parent %s", counterNow.GetParentId())
+	}
+}
@@ -0,0 +1,227 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NextPageTokenKey is the stream trailer in which the list rpcs return the cursor of the following page.
+const NextPageTokenKey = "next-page-token"
+
+// PartialResultError is returned when a listing stream fails after some objects were already received,
+// the objects received before the failure are valid but the listing is incomplete.
+type PartialResultError struct {
+	// Received is the number of objects obtained before the stream failed.
+	Received int
+	Err      error
+}
+
+func (e *PartialResultError) Error() string {
+	return fmt.Sprintf("listing interrupted after %d results : %v", e.Received, e.Err)
+}
+
+func (e *PartialResultError) Unwrap() error { return e.Err }
+
+// GRPCStatus exposes the status of the underlying failure so status.Code keeps working on the error.
+func (e *PartialResultError) GRPCStatus() *status.Status {
+	return status.Convert(e.Err)
+}
+
+// streamIterator drains a server stream one object at a time.
+type streamIterator[T any] struct {
+	recv    func() (*T, error)
+	trailer func() metadata.MD
+	cancel  context.CancelFunc
+
+	current       *T
+	received      int
+	nextPageToken string
+	err           error
+	done          bool
+}
+
+// Next advances to the next object of the stream, it returns false once the stream is exhausted,
+// has failed or was closed, after which Err reports why.
+func (it *streamIterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	obj, err := it.recv()
+	if err != nil {
+		it.finish(err)
+		return false
+	}
+
+	it.current = obj
+	it.received++
+	return true
+}
+
+// Err returns nil when the stream was fully consumed or closed early. When the stream fails after
+// objects were received the error is a *PartialResultError.
+func (it *streamIterator[T]) Err() error {
+	return it.err
+}
+
+// Received is the number of objects obtained from the stream so far.
+func (it *streamIterator[T]) Received() int {
+	return it.received
+}
+
+// NextPageToken is the cursor of the page following the one just streamed, it is only known once
+// the stream was fully consumed and is empty when there are no further pages.
+func (it *streamIterator[T]) NextPageToken() string {
+	return it.nextPageToken
+}
+
+// Close terminates the stream early, it is safe to call more than once.
+func (it *streamIterator[T]) Close() {
+	if !it.done {
+		it.done = true
+		it.current = nil
+		it.cancel()
+	}
+}
+
+func (it *streamIterator[T]) finish(err error) {
+	it.done = true
+	it.current = nil
+	it.cancel()
+
+	if errors.Is(err, io.EOF) {
+		if tokens := it.trailer().Get(NextPageTokenKey); len(tokens) > 0 {
+			it.nextPageToken = tokens[0]
+		}
+		return
+	}
+
+	err = toError(err)
+	if it.received > 0 {
+		it.err = &PartialResultError{Received: it.received, Err: err}
+		return
+	}
+	it.err = err
+}
+
+// TenantIterator walks the tenants streamed by the service without holding them all in memory.
+type TenantIterator struct {
+	streamIterator[TenantObject]
+}
+
+// Tenant returns the tenant the last call to Next advanced to.
+func (it *TenantIterator) Tenant() *TenantObject {
+	return it.current
+}
+
+// PartitionIterator walks the partitions streamed by the service without holding them all in memory.
+type PartitionIterator struct {
+	streamIterator[PartitionObject]
+}
+
+// Partition returns the partition the last call to Next advanced to.
+func (it *PartitionIterator) Partition() *PartitionObject {
+	return it.current
+}
+
+// partitionStream is satisfied by every client stream of partitions.
+type partitionStream interface {
+	Recv() (*PartitionObject, error)
+	Trailer() metadata.MD
+}
+
+func newPartitionIterator(stream partitionStream, cancel context.CancelFunc) *PartitionIterator {
+	return &PartitionIterator{streamIterator[PartitionObject]{recv: stream.Recv, trailer: stream.Trailer, cancel: cancel}}
+}
+
+// IterateTenants streams the tenants matching the query, the iterator must be drained or closed.
+func (partCl *PartitionClient) IterateTenants(
+	ctx context.Context,
+	query string,
+	count uint,
+	page uint) (*TenantIterator, error) {
+
+	request := SearchRequest{
+		Query: query,
+		Count: uint32(count),
+		Page:  uint32(page),
+	}
+
+	return partCl.SearchTenants(ctx, &request)
+}
+
+// SearchTenants streams the tenants selected by the supplied search request, the iterator must be drained or closed.
+func (partCl *PartitionClient) SearchTenants(ctx context.Context, request *SearchRequest) (*TenantIterator, error) {
+	if err := partCl.validate(request); err != nil {
+		return nil, err
+	}
+
+	cancelCtx, cancel := partCl.callContext(ctx, "ListTenant")
+
+	tenantStream, err := partCl.client.ListTenant(cancelCtx, request)
+	if err != nil {
+		cancel()
+		return nil, toError(err)
+	}
+
+	return &TenantIterator{streamIterator[TenantObject]{
+		recv: tenantStream.Recv, trailer: tenantStream.Trailer, cancel: cancel}}, nil
+}
+
+// IteratePartitions streams the partitions matching the query, the iterator must be drained or closed.
+func (partCl *PartitionClient) IteratePartitions(
+	ctx context.Context,
+	query string,
+	count uint,
+	page uint) (*PartitionIterator, error) {
+
+	request := SearchRequest{
+		Query: query,
+		Count: uint32(count),
+		Page:  uint32(page),
+	}
+
+	return partCl.SearchPartitions(ctx, &request)
+}
+
+// SearchPartitions streams the partitions selected by the supplied search request, the iterator must be drained or closed.
+func (partCl *PartitionClient) SearchPartitions(ctx context.Context, request *SearchRequest) (*PartitionIterator, error) {
+	if err := partCl.validate(request); err != nil {
+		return nil, err
+	}
+
+	cancelCtx, cancel := partCl.callContext(ctx, "ListPartition")
+
+	partitionStream, err := partCl.client.ListPartition(cancelCtx, request)
+	if err != nil {
+		cancel()
+		return nil, toError(err)
+	}
+
+	return newPartitionIterator(partitionStream, cancel), nil
+}
+
+// AccessIterator walks the access streamed by the service without holding it all in memory.
+type AccessIterator struct {
+	streamIterator[AccessObject]
+}
+
+// Access returns the access the last call to Next advanced to.
+func (it *AccessIterator) Access() *AccessObject {
+	return it.current
+}
+
+// accessStream is satisfied by every client stream of access.
+type accessStream interface {
+	Recv() (*AccessObject, error)
+	Trailer() metadata.MD
+}
+
+func newAccessIterator(stream accessStream, cancel context.CancelFunc) *AccessIterator {
+	return &AccessIterator{streamIterator[AccessObject]{recv: stream.Recv, trailer: stream.Trailer, cancel: cancel}}
+}
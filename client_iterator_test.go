@@ -0,0 +1,131 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestIteratePartitions(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err = partCl.NewPartition(ctx, tenant.GetTenantId(), fmt.Sprintf("branch %d", i), "a branch of the bank", nil)
+		if err != nil {
+			t.Fatalf("could not create partition : %v", err)
+		}
+	}
+
+	partitions, err := partCl.IteratePartitions(ctx, "", 0, 0)
+	if err != nil {
+		t.Fatalf("could not iterate partitions : %v", err)
+	}
+	for partitions.Next() {
+		if partitions.Partition() == nil {
+			t.Errorf("expected a partition at position %d", partitions.Received())
+		}
+	}
+	if partitions.Err() != nil || partitions.Received() != 3 {
+		t.Errorf("expected 3 partitions without error, got %d and %v", partitions.Received(), partitions.Err())
+	}
+
+	partitions, err = partCl.IteratePartitions(ctx, "", 0, 0)
+	if err != nil {
+		t.Fatalf("could not iterate partitions : %v", err)
+	}
+	if !partitions.Next() {
+		t.Fatalf("expected at least one partition : %v", partitions.Err())
+	}
+	partitions.Close()
+	if partitions.Next() || partitions.Err() != nil {
+		t.Errorf("expected a closed iterator to stop without error, got %v", partitions.Err())
+	}
+}
+
+func TestIterateTenantsPartialResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	mockStream := NewMockPartitionService_ListTenantClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl)
+
+	mockCl.EXPECT().ListTenant(gomock.Any(), gomock.Any()).Return(mockStream, nil).Times(2)
+	gomock.InOrder(
+		mockStream.EXPECT().Recv().Return(&TenantObject{TenantId: "tenant1"}, nil),
+		mockStream.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "connection reset")),
+		mockStream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+	mockStream.EXPECT().Trailer().Return(metadata.MD{})
+
+	tenants, err := partCl.ListTenants(context.Background(), "", 0, 0)
+
+	var partialErr *PartialResultError
+	if !errors.As(err, &partialErr) || partialErr.Received != 1 || len(tenants) != 1 {
+		t.Errorf("expected a partial result of one tenant, got %d tenants and %v", len(tenants), err)
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("expected the partial result to keep the unavailable status, got %v", status.Code(err))
+	}
+
+	tenants, err = partCl.ListTenants(context.Background(), "", 0, 0)
+	if err != nil || len(tenants) != 0 {
+		t.Errorf("expected an empty listing without error, got %d tenants and %v", len(tenants), err)
+	}
+}
+
+func TestListPartitionsPageCursor(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	for i := 0; i < 7; i++ {
+		_, err = partCl.NewPartition(ctx, tenant.GetTenantId(), fmt.Sprintf("branch %d", i), "a branch of the bank", nil)
+		if err != nil {
+			t.Fatalf("could not create partition : %v", err)
+		}
+	}
+
+	firstPage, pageToken, err := partCl.ListPartitionsPage(ctx, "", 5, "")
+	if err != nil || len(firstPage) != 5 || pageToken == "" {
+		t.Fatalf("expected a full first page and a token, got %d partitions, token %q and %v",
+			len(firstPage), pageToken, err)
+	}
+
+	// partitions created mid listing must not shift the following page
+	_, err = partCl.NewPartition(ctx, tenant.GetTenantId(), "late branch", "a branch created mid listing", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	secondPage, pageToken, err := partCl.ListPartitionsPage(ctx, "", 5, pageToken)
+	if err != nil || len(secondPage) != 3 || pageToken != "" {
+		t.Fatalf("expected the remaining 3 partitions without token, got %d partitions, token %q and %v",
+			len(secondPage), pageToken, err)
+	}
+	if secondPage[0].GetName() != "branch 5" {
+		t.Errorf("expected the second page to continue after the first, got %s", secondPage[0].GetName())
+	}
+
+	_, _, err = partCl.ListPartitionsPage(ctx, "", 5, "bm90LWEtbnVtYmVy")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument for a malformed token, got %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+package partitionv1
+
+import (
+	"time"
+
+	apic "github.com/antinvestor/apis"
+	"google.golang.org/grpc/metadata"
+)
+
+// ClientOption is an option that configures the partition client itself rather than the
+// underlying connection. It satisfies apic.ClientOption so it can be passed to NewPartitionsClient
+// alongside the connection options, where it is ignored by the dialer.
+type ClientOption interface {
+	apic.ClientOption
+	applyPartitionClient(*PartitionClient)
+}
+
+// WithClientInfo adds application name and version pairs to the x-ai-api-client header
+// sent with every request e.g. WithClientInfo("billing", "1.4.2").
+func WithClientInfo(keyval ...string) ClientOption {
+	return withClientInfo(keyval)
+}
+
+type withClientInfo []string
+
+func (w withClientInfo) Apply(*apic.DialSettings) {}
+
+func (w withClientInfo) applyPartitionClient(partCl *PartitionClient) {
+	partCl.clientInfo = append(partCl.clientInfo, w...)
+}
+
+// WithMetadata adds key value pairs that are sent as metadata with every request made by the client.
+// Metadata specific to a single call should be attached to the context with metadata.AppendToOutgoingContext.
+func WithMetadata(kv ...string) ClientOption {
+	return withMetadata{metadata.Pairs(kv...)}
+}
+
+type withMetadata struct{ md metadata.MD }
+
+func (w withMetadata) Apply(*apic.DialSettings) {}
+
+func (w withMetadata) applyPartitionClient(partCl *PartitionClient) {
+	partCl.callMetadata = metadata.Join(partCl.callMetadata, w.md)
+}
+
+// WithUnaryTimeout sets the timeout applied to unary calls whose context carries no deadline,
+// a timeout of zero or less leaves such calls unbounded.
+func WithUnaryTimeout(timeout time.Duration) ClientOption {
+	return withUnaryTimeout(timeout)
+}
+
+type withUnaryTimeout time.Duration
+
+func (w withUnaryTimeout) Apply(*apic.DialSettings) {}
+
+func (w withUnaryTimeout) applyPartitionClient(partCl *PartitionClient) {
+	partCl.unaryTimeout = time.Duration(w)
+}
+
+// WithStreamTimeout sets the timeout applied to the server streaming listings whose context carries no
+// deadline, a timeout of zero or less leaves such streams unbounded. Watch streams for as long as its
+// context allows and is left unbounded regardless, unless given a timeout with WithMethodTimeout.
+func WithStreamTimeout(timeout time.Duration) ClientOption {
+	return withStreamTimeout(timeout)
+}
+
+type withStreamTimeout time.Duration
+
+func (w withStreamTimeout) Apply(*apic.DialSettings) {}
+
+func (w withStreamTimeout) applyPartitionClient(partCl *PartitionClient) {
+	partCl.streamTimeout = time.Duration(w)
+}
+
+// WithMethodTimeout overrides the default timeout for a single rpc of the partition service,
+// the method is the rpc name as declared in the service e.g. "GetAccess" or "ListPartition".
+func WithMethodTimeout(method string, timeout time.Duration) ClientOption {
+	return withMethodTimeout{method: method, timeout: timeout}
+}
+
+type withMethodTimeout struct {
+	method  string
+	timeout time.Duration
+}
+
+func (w withMethodTimeout) Apply(*apic.DialSettings) {}
+
+func (w withMethodTimeout) applyPartitionClient(partCl *PartitionClient) {
+	if partCl.methodTimeouts == nil {
+		partCl.methodTimeouts = map[string]time.Duration{}
+	}
+	partCl.methodTimeouts[w.method] = w.timeout
+}
+
+// WithoutValidation stops the client from validating requests before sending them,
+// leaving the service to reject invalid requests.
+func WithoutValidation() ClientOption {
+	return withoutValidation{}
+}
+
+type withoutValidation struct{}
+
+func (w withoutValidation) Apply(*apic.DialSettings) {}
+
+func (w withoutValidation) applyPartitionClient(partCl *PartitionClient) {
+	partCl.skipValidation = true
+}
+
+// WithRetryPolicy replaces the DefaultRetryPolicy applied to unary calls,
+// a policy with MaxAttempts of one or less turns retries off.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return withRetryPolicy(policy)
+}
+
+type withRetryPolicy RetryPolicy
+
+func (w withRetryPolicy) Apply(*apic.DialSettings) {}
+
+func (w withRetryPolicy) applyPartitionClient(partCl *PartitionClient) {
+	partCl.retryPolicy = RetryPolicy(w)
+}
+
+// WithCache serves GetPartition, GetAccess and GetEffectiveAccess from an in memory cache bounded by config,
+// concurrent lookups of the same partition or access share one call to the service. Changes made through the
+// client drop the affected entries, changes made elsewhere are seen once entries expire. A lookup shared
+// between callers does not carry their outgoing metadata, such as request ids, other than the keys listed in
+// CacheConfig.ForwardMetadata, and is bounded by CacheConfig.LookupTimeout even when unary calls are not.
+func WithCache(config CacheConfig) ClientOption {
+	return withCache(config)
+}
+
+type withCache CacheConfig
+
+func (w withCache) Apply(*apic.DialSettings) {}
+
+func (w withCache) applyPartitionClient(partCl *PartitionClient) {
+	partCl.cache = newLookupCache(CacheConfig(w))
+}
+
+func applyClientOptions(partCl *PartitionClient, opts []apic.ClientOption) {
+	for _, opt := range opts {
+		if partOpt, ok := opt.(ClientOption); ok {
+			partOpt.applyPartitionClient(partCl)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package partitionv1
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	minSearchCount      = 5
+	maxSearchCount      = 499
+	defaultWalkPageSize = 100
+)
+
+// pageResult holds the outcome of fetching a single page of a listing.
+type pageResult[T any] struct {
+	objects []*T
+	err     error
+}
+
+// walkPages fetches consecutive pages starting at page one until a page shorter than pageSize is found.
+// Up to workers pages are fetched concurrently, results are still handed to fn in page order and
+// objects whose id was already seen on an earlier page are skipped.
+func walkPages[T any](
+	pageSize uint,
+	workers int,
+	fetch func(page uint) ([]*T, error),
+	id func(*T) string,
+	fn func(*T) error) error {
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	seen := map[string]struct{}{}
+	for firstPage := uint(1); ; firstPage += uint(workers) {
+		results := make([]pageResult[T], workers)
+
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i].objects, results[i].err = fetch(firstPage + uint(i))
+			}(i)
+		}
+		wg.Wait()
+
+		for _, result := range results {
+			if result.err != nil {
+				return result.err
+			}
+
+			for _, obj := range result.objects {
+				if _, ok := seen[id(obj)]; ok {
+					continue
+				}
+				seen[id(obj)] = struct{}{}
+
+				if err := fn(obj); err != nil {
+					return err
+				}
+			}
+
+			if uint(len(result.objects)) < pageSize {
+				return nil
+			}
+		}
+	}
+}
+
+// walkPageSize keeps the page size within the bounds accepted by SearchRequest.
+func walkPageSize(pageSize uint) uint {
+	switch {
+	case pageSize == 0:
+		return defaultWalkPageSize
+	case pageSize < minSearchCount:
+		return minSearchCount
+	case pageSize > maxSearchCount:
+		return maxSearchCount
+	default:
+		return pageSize
+	}
+}
+
+// WalkTenants calls fn for every tenant matching the query, fetching pages of pageSize tenants until
+// the last page is reached. Up to workers pages are fetched concurrently, tenants that shift between
+// pages while the walk is in progress are only reported once. Walking stops at the first error,
+// including one returned by fn.
+func (partCl *PartitionClient) WalkTenants(
+	ctx context.Context,
+	query string,
+	pageSize uint,
+	workers int,
+	fn func(*TenantObject) error) error {
+
+	pageSize = walkPageSize(pageSize)
+	return walkPages(pageSize, workers,
+		func(page uint) ([]*TenantObject, error) {
+			return partCl.ListTenants(ctx, query, pageSize, page)
+		},
+		(*TenantObject).GetTenantId, fn)
+}
+
+// WalkPartitions calls fn for every partition matching the query, fetching pages of pageSize partitions
+// until the last page is reached. Up to workers pages are fetched concurrently, partitions that shift
+// between pages while the walk is in progress are only reported once. Walking stops at the first error,
+// including one returned by fn.
+func (partCl *PartitionClient) WalkPartitions(
+	ctx context.Context,
+	query string,
+	pageSize uint,
+	workers int,
+	fn func(*PartitionObject) error) error {
+
+	pageSize = walkPageSize(pageSize)
+	return walkPages(pageSize, workers,
+		func(page uint) ([]*PartitionObject, error) {
+			return partCl.ListPartitions(ctx, query, pageSize, page)
+		},
+		(*PartitionObject).GetPartitionId, fn)
+}
@@ -0,0 +1,70 @@
+package partitionv1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestWalkPartitions(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	for i := 0; i < 12; i++ {
+		_, err = partCl.NewPartition(ctx, tenant.GetTenantId(), fmt.Sprintf("branch %d", i), "a branch of the bank", nil)
+		if err != nil {
+			t.Fatalf("could not create partition : %v", err)
+		}
+	}
+
+	for _, workers := range []int{1, 3} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			var names []string
+			err := partCl.WalkPartitions(ctx, "branch", 5, workers, func(partition *PartitionObject) error {
+				names = append(names, partition.GetName())
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("could not walk partitions : %v", err)
+			}
+
+			if len(names) != 12 {
+				t.Fatalf("expected 12 partitions, got %d", len(names))
+			}
+			for i, name := range names {
+				if name != fmt.Sprintf("branch %d", i) {
+					t.Errorf("expected partitions in page order, got %s at position %d", name, i)
+				}
+			}
+		})
+	}
+}
+
+func TestWalkPagesSkipsShiftedObjects(t *testing.T) {
+	pages := map[uint][]*TenantObject{
+		1: {{TenantId: "t1"}, {TenantId: "t2"}},
+		2: {{TenantId: "t2"}, {TenantId: "t3"}},
+		3: {{TenantId: "t4"}},
+	}
+
+	var ids []string
+	err := walkPages(2, 2,
+		func(page uint) ([]*TenantObject, error) { return pages[page], nil },
+		(*TenantObject).GetTenantId,
+		func(tenant *TenantObject) error {
+			ids = append(ids, tenant.GetTenantId())
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("could not walk pages : %v", err)
+	}
+
+	if fmt.Sprint(ids) != "[t1 t2 t3 t4]" {
+		t.Errorf("expected each tenant once in page order, got %v", ids)
+	}
+}
@@ -0,0 +1,188 @@
+package partitionv1
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// IdempotencyKeyHeader is the request metadata carrying the idempotency key of a create,
+// creates are only retried when they carry one. The service answers a create repeated with the
+// same key with the object created the first time, for as long as it retains the key.
+const IdempotencyKeyHeader = "x-idempotency-key"
+
+// idempotencyKeyContextKey is the context key under which WithIdempotencyKey stores its key.
+type idempotencyKeyContextKey struct{}
+
+// idempotencyKey is claimed by the first create made with the context carrying it.
+type idempotencyKey struct {
+	key     string
+	claimed int32
+}
+
+// WithIdempotencyKey returns a context whose key is sent with the first create made with it, use it
+// to make a create safe to repeat across separate calls e.g. when a job is rerun after a crash.
+// The key applies to that single create, other calls made with the context, including further creates,
+// do not carry it. Creates made without a key get one generated for the duration of the call.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, &idempotencyKey{key: key})
+}
+
+// idempotentContext ensures a create made with ctx carries an idempotency key, so a create retried by
+// the client is only applied once. It claims the key attached with WithIdempotencyKey when still unused
+// and generates one otherwise, so it is called once the request is validated and about to be sent.
+func idempotentContext(ctx context.Context) context.Context {
+	var key string
+	if attached, ok := ctx.Value(idempotencyKeyContextKey{}).(*idempotencyKey); ok &&
+		atomic.CompareAndSwapInt32(&attached.claimed, 0, 1) {
+		key = attached.key
+	} else {
+		b := make([]byte, 16)
+		_, _ = crand.Read(b)
+		key = hex.EncodeToString(b)
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(IdempotencyKeyHeader, key)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// idempotentMethods are the unary rpcs that can be repeated without side effects, these are retried
+// whenever the failure is retryable.
+var idempotentMethods = map[string]bool{
+	"GetTenant":             true,
+	"GetPartition":          true,
+	"GetPartitionAncestors": true,
+	"ListPartitionRoles":    true,
+	"GetPage":               true,
+	"ListPages":             true,
+	"ListPageVersions":      true,
+	"RenderPage":            true,
+	"GetAccess":             true,
+	"ListAccessRoles":       true,
+	"CheckAccess":           true,
+	"CheckAccessBatch":      true,
+}
+
+// keyHonoredMethods are the writes the service deduplicates by idempotency key, these are retried
+// when they carry one. Other writes are never retried as repeating them could apply them twice.
+var keyHonoredMethods = map[string]bool{
+	"CreateTenant":        true,
+	"CreatePartition":     true,
+	"CreatePartitionRole": true,
+	"CreatePage":          true,
+	"CreateAccess":        true,
+	"CreateAccessRole":    true,
+}
+
+// RetryPolicy controls how unary calls that fail with a retryable code are repeated.
+// Read rpcs are retried automatically, creates only when they carry an idempotency key.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one, one or less disables retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, each further wait grows by Multiplier up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// Jitter is the fraction by which each wait is randomly shortened or lengthened e.g. 0.2 for ±20%.
+	Jitter float64
+	// RetryableCodes are the status codes worth another attempt.
+	RetryableCodes []codes.Code
+}
+
+// DefaultRetryPolicy is the policy used by clients not configured with WithRetryPolicy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: time.Millisecond * 100,
+		MaxBackoff:     time.Second * 2,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableCodes: []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.Aborted},
+	}
+}
+
+func (policy RetryPolicy) retryable(err error) bool {
+	code := status.Code(err)
+	for _, retryableCode := range policy.RetryableCodes {
+		if code == retryableCode {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// backoff is the wait before the retry following attempt, attempts are counted from one.
+func (policy RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	backoff := float64(policy.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if policy.MaxBackoff > 0 && backoff > float64(policy.MaxBackoff) {
+		backoff = float64(policy.MaxBackoff)
+	}
+
+	if policy.Jitter > 0 {
+		jitterMu.Lock()
+		backoff *= 1 + policy.Jitter*(2*jitterRand.Float64()-1)
+		jitterMu.Unlock()
+	}
+	return time.Duration(backoff)
+}
+
+// hasIdempotencyKey reports whether the outgoing metadata of ctx carries an idempotency key.
+func hasIdempotencyKey(ctx context.Context) bool {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	keys := md.Get(IdempotencyKeyHeader)
+	return len(keys) > 0 && keys[0] != ""
+}
+
+// invoke calls the unary rpc method, repeating it according to the retry policy of the client when it is
+// safe to do so, and maps the final failure onto the package errors.
+func invoke[Req any, Resp any](
+	ctx context.Context,
+	partCl *PartitionClient,
+	method string,
+	rpc func(context.Context, *Req, ...grpc.CallOption) (*Resp, error),
+	request *Req) (*Resp, error) {
+
+	policy := partCl.retryPolicy
+	canRetry := idempotentMethods[method] || (keyHonoredMethods[method] && hasIdempotencyKey(ctx))
+
+	for attempt := 1; ; attempt++ {
+		response, err := rpc(ctx, request)
+		if err == nil {
+			return response, nil
+		}
+
+		if !canRetry || attempt >= policy.MaxAttempts || !policy.retryable(err) {
+			return nil, toError(err)
+		}
+
+		timer := time.NewTimer(policy.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, toError(err)
+		case <-timer.C:
+		}
+	}
+}
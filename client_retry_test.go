@@ -0,0 +1,206 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = time.Millisecond * 5
+	return policy
+}
+
+func TestRetryReads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithRetryPolicy(testRetryPolicy()))
+
+	unavailable := status.Error(codes.Unavailable, "partition service is restarting")
+	gomock.InOrder(
+		mockCl.EXPECT().GetPartition(gomock.Any(), gomock.Any()).Return(nil, unavailable).Times(2),
+		mockCl.EXPECT().GetPartition(gomock.Any(), gomock.Any()).Return(&PartitionObject{PartitionId: "partition1"}, nil),
+	)
+
+	partition, err := partCl.GetPartition(context.Background(), "partition1")
+	if err != nil {
+		t.Fatalf("expected the read to succeed after retrying, got %v", err)
+	}
+	if partition.GetPartitionId() != "partition1" {
+		t.Errorf("got partition %s, expected partition1", partition.GetPartitionId())
+	}
+
+	mockCl.EXPECT().GetAccess(gomock.Any(), gomock.Any()).Return(nil, unavailable).Times(4)
+
+	_, err = partCl.GetAccess(context.Background(), "partition1", "profile1")
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected the last failure once attempts run out, got %v", err)
+	}
+
+	mockCl.EXPECT().ListPartitionRoles(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "partition partition1 not found"))
+
+	_, err = partCl.ListPartitionRoles(context.Background(), "partition1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a non retryable failure to be returned at once, got %v", err)
+	}
+}
+
+func TestRetryWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithRetryPolicy(testRetryPolicy()))
+
+	unavailable := status.Error(codes.Unavailable, "partition service is restarting")
+	mockCl.EXPECT().UpdatePartition(gomock.Any(), gomock.Any()).Return(nil, unavailable)
+
+	_, err := partCl.UpdatePartition(context.Background(), "partition1", "bank", "the bank partition", nil)
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected a write without idempotency key not to be retried, got %v", err)
+	}
+
+	// The service only deduplicates creates by key, other writes carrying one must not be repeated.
+	ctx := metadata.AppendToOutgoingContext(context.Background(), IdempotencyKeyHeader, "job-42")
+
+	mockCl.EXPECT().UpdatePartition(gomock.Any(), gomock.Any()).Return(nil, unavailable)
+	_, err = partCl.UpdatePartition(ctx, "partition1", "bank", "the bank partition", nil)
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected a keyed update not to be retried, got %v", err)
+	}
+
+	mockCl.EXPECT().RemoveAccess(gomock.Any(), gomock.Any()).Return(nil, unavailable)
+	_, err = partCl.RemoveAccess(ctx, "access1")
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected a keyed remove not to be retried, got %v", err)
+	}
+
+	mockCl.EXPECT().RollbackPage(gomock.Any(), gomock.Any()).Return(nil, unavailable)
+	_, err = partCl.RollbackPage(ctx, "page1", 1)
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected a keyed rollback not to be retried, got %v", err)
+	}
+
+	gomock.InOrder(
+		mockCl.EXPECT().CreateAccess(gomock.Any(), gomock.Any()).Return(nil, unavailable),
+		mockCl.EXPECT().CreateAccess(gomock.Any(), gomock.Any()).Return(&AccessObject{AccessId: "access1"}, nil),
+	)
+	_, err = partCl.CreateAccess(ctx, "partition1", "profile1")
+	if err != nil {
+		t.Errorf("expected a keyed create to be retried, got %v", err)
+	}
+}
+
+func TestRetryDisabledAndCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+	unavailable := status.Error(codes.Unavailable, "partition service is restarting")
+	mockCl.EXPECT().GetTenant(gomock.Any(), gomock.Any()).Return(nil, unavailable)
+
+	_, err := partCl.GetTenant(context.Background(), "tenant1")
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected no retries with a single attempt, got %v", err)
+	}
+
+	policy := testRetryPolicy()
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	partCl = InstantiatePartitionsClient(nil, mockCl, WithRetryPolicy(policy))
+
+	mockCl.EXPECT().GetTenant(gomock.Any(), gomock.Any()).Return(nil, unavailable)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	_, err = partCl.GetTenant(ctx, "tenant1")
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected the wait for a retry to end with the context, got %v", err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Millisecond * 100, MaxBackoff: time.Second, Multiplier: 2}
+
+	expected := []time.Duration{time.Millisecond * 100, time.Millisecond * 200, time.Millisecond * 400,
+		time.Millisecond * 800, time.Second, time.Second}
+	for i, backoff := range expected {
+		if got := policy.backoff(i + 1); got != backoff {
+			t.Errorf("backoff after attempt %d is %v, expected %v", i+1, got, backoff)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := policy.backoff(1); got < time.Millisecond*50 || got > time.Millisecond*150 {
+			t.Fatalf("backoff %v is outside the jitter bounds", got)
+		}
+	}
+}
+
+func TestCreateIsRetriedWithOneKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithRetryPolicy(testRetryPolicy()))
+
+	var keys []string
+	recordKey := func(ctx context.Context, in *PartitionRoleCreateRequest, opts ...grpc.CallOption) {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		keys = append(keys, md.Get(IdempotencyKeyHeader)...)
+	}
+
+	gomock.InOrder(
+		mockCl.EXPECT().CreatePartitionRole(gomock.Any(), gomock.Any()).Do(recordKey).
+			Return(nil, status.Error(codes.Unavailable, "partition service is restarting")),
+		mockCl.EXPECT().CreatePartitionRole(gomock.Any(), gomock.Any()).Do(recordKey).
+			Return(&PartitionRoleObject{Name: "admin"}, nil),
+	)
+
+	_, err := partCl.CreatePartitionRole(context.Background(), "partition1", "admin", nil)
+	if err != nil {
+		t.Fatalf("expected the create to be retried, got %v", err)
+	}
+	if len(keys) != 2 || keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("expected both attempts to carry the same generated key, got %v", keys)
+	}
+}
+
+func TestIdempotencyKeyIsNotSentWithOtherWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithRetryPolicy(testRetryPolicy()))
+
+	var keys []string
+	mockCl.EXPECT().UpdatePartition(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, in *PartitionUpdateRequest, opts ...grpc.CallOption) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			keys = append(keys, md.Get(IdempotencyKeyHeader)...)
+		}).Return(&PartitionObject{Name: "bank"}, nil)
+
+	ctx := WithIdempotencyKey(context.Background(), "job-42")
+	if _, err := partCl.UpdatePartition(ctx, "partition1", "bank", "the bank partition", nil); err != nil {
+		t.Fatalf("could not update partition : %v", err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("expected the update not to carry the idempotency key, got %v", keys)
+	}
+}
@@ -2,9 +2,14 @@ package partitionv1
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/antinvestor/apis"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestNewProfileClient(t *testing.T) {
@@ -15,3 +20,76 @@ func TestNewProfileClient(t *testing.T) {
 	}
 
 }
+
+func TestClientMetadataIsSent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl,
+		WithClientInfo("billing", "1.4.2"), WithMetadata("x-tenant-hint", "bank"))
+
+	mockCl.EXPECT().GetPartition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionObject, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+
+			clientInfo := md.Get("x-ai-api-client")
+			if len(clientInfo) != 1 || !strings.Contains(clientInfo[0], "billing/1.4.2") {
+				t.Errorf("x-ai-api-client header is %v, expected it to include the application info", clientInfo)
+			}
+			if hint := md.Get("x-tenant-hint"); len(hint) != 1 || hint[0] != "bank" {
+				t.Errorf("x-tenant-hint header is %v, expected bank", hint)
+			}
+			if requestId := md.Get("x-request-id"); len(requestId) != 1 || requestId[0] != "req-1" {
+				t.Errorf("x-request-id header is %v, expected the caller supplied value", requestId)
+			}
+			return &PartitionObject{PartitionId: in.GetId()}, nil
+		})
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "req-1")
+	_, err := partCl.GetPartition(ctx, "partition1")
+	if err != nil {
+		t.Errorf("could not get partition : %v", err)
+	}
+}
+
+func TestClientTimeouts(t *testing.T) {
+	partCl := InstantiatePartitionsClient(nil, nil,
+		WithUnaryTimeout(time.Second), WithMethodTimeout("GetAccess", time.Millisecond*200))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		method  string
+		timeout time.Duration
+	}{
+		{name: "unary default", ctx: context.Background(), method: "GetPartition", timeout: time.Second},
+		{name: "stream default", ctx: context.Background(), method: "ListPartition", timeout: defaultStreamTimeout},
+		{name: "method override", ctx: context.Background(), method: "GetAccess", timeout: time.Millisecond * 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callCtx, cancel := partCl.callContext(tt.ctx, tt.method)
+			defer cancel()
+
+			deadline, ok := callCtx.Deadline()
+			if !ok {
+				t.Fatalf("expected a deadline on the call context")
+			}
+			if remaining := time.Until(deadline); remaining > tt.timeout || remaining < tt.timeout-time.Millisecond*100 {
+				t.Errorf("call deadline is %v away, expected about %v", remaining, tt.timeout)
+			}
+		})
+	}
+
+	callerCtx, callerCancel := context.WithTimeout(context.Background(), time.Minute)
+	defer callerCancel()
+	callerDeadline, _ := callerCtx.Deadline()
+
+	callCtx, cancel := partCl.callContext(callerCtx, "GetPartition")
+	defer cancel()
+	if deadline, _ := callCtx.Deadline(); !deadline.Equal(callerDeadline) {
+		t.Errorf("call deadline %v does not respect the caller deadline %v", deadline, callerDeadline)
+	}
+}
@@ -0,0 +1,140 @@
+package partitionv1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validator is implemented by every request message of the partition service.
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldValidationError is implemented by the generated *ValidationError types.
+type fieldValidationError interface {
+	error
+	Field() string
+	Reason() string
+	Cause() error
+	ErrorName() string
+}
+
+// multiValidationError is implemented by the generated *MultiError types.
+type multiValidationError interface {
+	error
+	AllErrors() []error
+}
+
+// FieldViolation is a single field rejected by validation.
+type FieldViolation struct {
+	// Field is the path to the offending field e.g. "Description" or "Partition[0].PartitionId".
+	Field string
+	// Reason describes the rule the field value broke.
+	Reason string
+}
+
+// FieldViolations flattens the error returned by ValidateAll on any message of the partition service,
+// or an error returned by the client for a request rejected by validation, into the list of fields
+// that were rejected. Errors that are not validation errors yield no violations.
+func FieldViolations(err error) []FieldViolation {
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return fieldErr.Violations
+	}
+	var serviceErr *Error
+	if errors.As(err, &serviceErr) {
+		return serviceErr.Violations
+	}
+	return flattenViolations("", err)
+}
+
+func flattenViolations(prefix string, err error) []FieldViolation {
+	var multi multiValidationError
+	if errors.As(err, &multi) {
+		var violations []FieldViolation
+		for _, violationErr := range multi.AllErrors() {
+			violations = append(violations, flattenViolations(prefix, violationErr)...)
+		}
+		return violations
+	}
+
+	var violation fieldValidationError
+	if !errors.As(err, &violation) {
+		return nil
+	}
+
+	field := prefix + violation.Field()
+	if nested := flattenViolations(field+".", violation.Cause()); len(nested) > 0 {
+		return nested
+	}
+	return []FieldViolation{{Field: field, Reason: violation.Reason()}}
+}
+
+// FieldError is returned by the client when a request is rejected by validation before being sent,
+// it identifies the offending fields so callers can map them back to the inputs that supplied them.
+type FieldError struct {
+	// Request is the name of the rejected request message e.g. "TenantRequest".
+	Request string
+	// Field and Reason describe the first violation, all of them are listed in Violations.
+	Field  string
+	Reason string
+
+	Violations []FieldViolation
+	// Err is the validation error generated for the request message.
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	if len(e.Violations) > 1 {
+		return fmt.Sprintf("invalid %s.%s : %s (and %d more violations)",
+			e.Request, e.Field, e.Reason, len(e.Violations)-1)
+	}
+	return fmt.Sprintf("invalid %s.%s : %s", e.Request, e.Field, e.Reason)
+}
+
+func (e *FieldError) Unwrap() error { return e.Err }
+
+// GRPCStatus reports the error as an invalid argument, matching what the service returns for the same request.
+func (e *FieldError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+// newFieldError converts the error returned by a generated ValidateAll method into a *FieldError,
+// following the causes of embedded messages to build the full path of the offending fields.
+func newFieldError(err error) error {
+	first := err
+	if multi, ok := err.(multiValidationError); ok && len(multi.AllErrors()) > 0 {
+		first = multi.AllErrors()[0]
+	}
+
+	var violation fieldValidationError
+	violations := flattenViolations("", err)
+	if !errors.As(first, &violation) || len(violations) == 0 {
+		return err
+	}
+
+	return &FieldError{
+		Request:    strings.TrimSuffix(violation.ErrorName(), "ValidationError"),
+		Field:      violations[0].Field,
+		Reason:     violations[0].Reason,
+		Violations: violations,
+		Err:        err,
+	}
+}
+
+// validate checks request against the rules declared in the proto definition,
+// unless validation was turned off with WithoutValidation.
+func (partCl *PartitionClient) validate(request validator) error {
+	if partCl.skipValidation {
+		return nil
+	}
+
+	if err := request.ValidateAll(); err != nil {
+		return newFieldError(err)
+	}
+	return nil
+}
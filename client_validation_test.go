@@ -0,0 +1,135 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientValidatesRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl)
+
+	_, err := partCl.NewTenant(context.Background(), "bank", "short", nil)
+
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("expected a field error for a short description, got %v", err)
+	}
+	if fieldErr.Request != "TenantRequest" || fieldErr.Field != "Description" || fieldErr.Reason == "" {
+		t.Errorf("field error does not identify the description, got %+v", fieldErr)
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected the field error to report invalid argument, got %v", status.Code(err))
+	}
+
+	_, _, err = partCl.ListPartitionsPage(context.Background(), "", 1000, "")
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "Count" {
+		t.Errorf("expected a field error for the page size of a listing, got %v", err)
+	}
+}
+
+func TestClientWithoutValidation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithoutValidation())
+
+	mockCl.EXPECT().CreateTenant(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "invalid description"))
+
+	_, err := partCl.NewTenant(context.Background(), "bank", "short", nil)
+
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		t.Errorf("expected the request to reach the service, got field error %v", fieldErr)
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected the service error to be returned, got %v", err)
+	}
+}
+
+func TestFieldErrorPath(t *testing.T) {
+	response := PartitionListResponse{
+		Partition: []*PartitionObject{{PartitionId: "x"}},
+	}
+
+	err := newFieldError(response.Validate())
+
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("expected a field error, got %v", err)
+	}
+	if fieldErr.Request != "PartitionListResponse" || fieldErr.Field != "Partition[0].PartitionId" {
+		t.Errorf("expected the path to the embedded partition id, got %s.%s", fieldErr.Request, fieldErr.Field)
+	}
+}
+
+func TestFieldViolations(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  validator
+		expected []FieldViolation
+	}{
+		{
+			name:    "tenant request",
+			request: &TenantRequest{Name: "bk", Description: "short"},
+			expected: []FieldViolation{
+				{Field: "Name", Reason: "value length must be between 3 and 100 runes, inclusive"},
+				{Field: "Description", Reason: "value length must be between 10 and 500 runes, inclusive"},
+			},
+		},
+		{
+			name:    "partition create request",
+			request: &PartitionCreateRequest{TenantId: "tenant1", Name: "bank", Description: "the bank partition"},
+		},
+		{
+			name: "embedded partitions",
+			request: &PartitionListResponse{
+				Partition: []*PartitionObject{
+					{PartitionId: "partition1", TenantId: "tenant1", Name: "bank", Description: "the bank partition"},
+					{PartitionId: "PARTITION", TenantId: "tenant1", Name: "branch", Description: "short"},
+				},
+			},
+			expected: []FieldViolation{
+				{Field: "Partition[1].PartitionId", Reason: `value does not match regex pattern "^[0-9a-z_-]+$"`},
+				{Field: "Partition[1].Description"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := FieldViolations(tt.request.ValidateAll())
+			if len(violations) != len(tt.expected) {
+				t.Fatalf("got violations %v, expected %v", violations, tt.expected)
+			}
+			for i, violation := range violations {
+				if violation.Field != tt.expected[i].Field {
+					t.Errorf("violation %d is for field %s, expected %s", i, violation.Field, tt.expected[i].Field)
+				}
+				if tt.expected[i].Reason != "" && violation.Reason != tt.expected[i].Reason {
+					t.Errorf("violation %d reason is %q, expected %q", i, violation.Reason, tt.expected[i].Reason)
+				}
+			}
+		})
+	}
+}
+
+func TestClientReportsAllViolations(t *testing.T) {
+	_, partCl := newFakeClient(t)
+
+	_, err := partCl.NewTenant(context.Background(), "bk", "short", nil)
+
+	violations := FieldViolations(err)
+	if len(violations) != 2 || violations[0].Field != "Name" || violations[1].Field != "Description" {
+		t.Errorf("expected both the name and the description to be reported, got %v", violations)
+	}
+}
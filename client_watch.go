@@ -0,0 +1,70 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Watch calls fn with every change matching request in sequence order until ctx is done or fn returns
+// an error, which Watch then returns. When the stream breaks with a retryable failure Watch reconnects
+// following the retry policy of the client and resumes from the last position the service reported,
+// through the changes or the heartbeats it sends, so changes are neither missed nor repeated once the
+// watch opened. Heartbeats are tracked by Watch and not passed to fn. A failure matching ErrOutOfRange means the
+// changes after the requested sequence are no longer retained and the caller has to resync before watching again.
+func (partCl *PartitionClient) Watch(ctx context.Context, request *WatchRequest, fn func(*ChangeEvent) error) error {
+	if request == nil {
+		request = &WatchRequest{}
+	}
+	request = proto.Clone(request).(*WatchRequest)
+
+	if err := partCl.validate(request); err != nil {
+		return err
+	}
+
+	policy := partCl.retryPolicy
+	for attempt := 1; ; attempt++ {
+		cancelCtx, cancel := partCl.callContext(ctx, "Watch")
+
+		stream, err := partCl.client.Watch(cancelCtx, request)
+		for err == nil {
+			var event *ChangeEvent
+			event, err = stream.Recv()
+			if err != nil {
+				break
+			}
+
+			attempt = 1
+			request.AfterSequence = event.GetSequence()
+			request.Resume = true
+			if event.GetChange() == ChangeType_CHANGE_HEARTBEAT {
+				continue
+			}
+			if fnErr := fn(event); fnErr != nil {
+				cancel()
+				return fnErr
+			}
+		}
+		cancel()
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		canResume := errors.Is(err, io.EOF) || policy.retryable(err)
+		if !canResume || attempt >= policy.MaxAttempts {
+			return toError(err)
+		}
+
+		timer := time.NewTimer(policy.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWatchChanges(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	partition, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	var seen []*ChangeEvent
+	request := &WatchRequest{AfterSequence: 1, Resources: []ResourceType{ResourceType_RESOURCE_PARTITION, ResourceType_RESOURCE_ACCESS}}
+	done := make(chan error, 1)
+	stop := errors.New("seen enough changes")
+	go func() {
+		done <- partCl.Watch(ctx, request, func(event *ChangeEvent) error {
+			seen = append(seen, event)
+			if len(seen) == 3 {
+				return stop
+			}
+			return nil
+		})
+	}()
+
+	access, err := partCl.CreateAccess(ctx, partition.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not create access : %v", err)
+	}
+
+	_, err = partCl.RemoveAccess(ctx, access.GetAccessId())
+	if err != nil {
+		t.Fatalf("could not remove access : %v", err)
+	}
+
+	if err = <-done; !errors.Is(err, stop) {
+		t.Fatalf("expected the watch to end with the error of the callback, got %v", err)
+	}
+
+	expected := []struct {
+		change   ChangeType
+		resource ResourceType
+		id       string
+	}{
+		{ChangeType_CHANGE_CREATED, ResourceType_RESOURCE_PARTITION, partition.GetPartitionId()},
+		{ChangeType_CHANGE_CREATED, ResourceType_RESOURCE_ACCESS, access.GetAccessId()},
+		{ChangeType_CHANGE_REMOVED, ResourceType_RESOURCE_ACCESS, access.GetAccessId()},
+	}
+	for i, event := range seen {
+		if event.GetChange() != expected[i].change || event.GetResource() != expected[i].resource || event.GetId() != expected[i].id {
+			t.Errorf("event %d is %v %v %s, expected %v", i, event.GetChange(), event.GetResource(), event.GetId(), expected[i])
+		}
+		if i > 0 && event.GetSequence() <= seen[i-1].GetSequence() {
+			t.Errorf("expected increasing sequences, got %d after %d", event.GetSequence(), seen[i-1].GetSequence())
+		}
+	}
+	if seen[2].GetAccess().GetPartition().GetPartitionId() != partition.GetPartitionId() {
+		t.Errorf("expected the removed access to be reported with its last state, got %v", seen[2].GetAccess())
+	}
+
+	err = partCl.Watch(ctx, &WatchRequest{AfterSequence: 1000}, func(*ChangeEvent) error { return nil })
+	if status.Code(err) != codes.OutOfRange || !errors.Is(err, ErrOutOfRange) || errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("expected out of range resuming after an unknown sequence, got %v", err)
+	}
+}
+
+func TestWatchResumesAfterFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithRetryPolicy(testRetryPolicy()))
+
+	broken := NewMockPartitionService_WatchClient(ctrl)
+	gomock.InOrder(
+		broken.EXPECT().Recv().Return(&ChangeEvent{Sequence: 7}, nil),
+		broken.EXPECT().Recv().Return(&ChangeEvent{Sequence: 8}, nil),
+		broken.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "connection reset")),
+	)
+
+	resumed := NewMockPartitionService_WatchClient(ctrl)
+	resumed.EXPECT().Recv().Return(&ChangeEvent{Sequence: 9}, nil)
+
+	gomock.InOrder(
+		mockCl.EXPECT().Watch(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionService_WatchClient, error) {
+				if _, ok := ctx.Deadline(); ok {
+					t.Errorf("expected the watch to have no deadline")
+				}
+				if in.GetAfterSequence() != 6 {
+					t.Errorf("expected the watch to start after sequence 6, got %d", in.GetAfterSequence())
+				}
+				return broken, nil
+			}),
+		mockCl.EXPECT().Watch(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionService_WatchClient, error) {
+				if in.GetAfterSequence() != 8 {
+					t.Errorf("expected the watch to resume after sequence 8, got %d", in.GetAfterSequence())
+				}
+				return resumed, nil
+			}),
+	)
+
+	var sequences []uint64
+	stop := errors.New("seen enough changes")
+	err := partCl.Watch(context.Background(), &WatchRequest{AfterSequence: 6}, func(event *ChangeEvent) error {
+		sequences = append(sequences, event.GetSequence())
+		if event.GetSequence() == 9 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("expected the watch to end with the error of the callback, got %v", err)
+	}
+	if len(sequences) != 3 {
+		t.Errorf("expected every change once, got sequences %v", sequences)
+	}
+
+}
+
+func TestWatchResumesFromHeartbeat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCl := NewMockPartitionServiceClient(ctrl)
+	partCl := InstantiatePartitionsClient(nil, mockCl, WithRetryPolicy(testRetryPolicy()))
+
+	broken := NewMockPartitionService_WatchClient(ctrl)
+	gomock.InOrder(
+		broken.EXPECT().Recv().Return(&ChangeEvent{Sequence: 5, Change: ChangeType_CHANGE_HEARTBEAT}, nil),
+		broken.EXPECT().Recv().Return(nil, status.Error(codes.Unavailable, "connection reset")),
+	)
+
+	resumed := NewMockPartitionService_WatchClient(ctrl)
+	resumed.EXPECT().Recv().Return(&ChangeEvent{Sequence: 6, Change: ChangeType_CHANGE_CREATED}, nil)
+
+	gomock.InOrder(
+		mockCl.EXPECT().Watch(gomock.Any(), gomock.Any()).Return(broken, nil),
+		mockCl.EXPECT().Watch(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionService_WatchClient, error) {
+				if in.GetAfterSequence() != 5 || !in.GetResume() {
+					t.Errorf("expected the watch to resume after the heartbeat at 5, got %v", in)
+				}
+				return resumed, nil
+			}),
+	)
+
+	var changes []ChangeType
+	stop := errors.New("seen enough changes")
+	err := partCl.Watch(context.Background(), &WatchRequest{}, func(event *ChangeEvent) error {
+		changes = append(changes, event.GetChange())
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("expected the watch to end with the error of the callback, got %v", err)
+	}
+	if len(changes) != 1 || changes[0] != ChangeType_CHANGE_CREATED {
+		t.Errorf("expected heartbeats to be kept from the callback, got %v", changes)
+	}
+}
+
+func TestFakeWatchHeartbeats(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	fakeServer, partCl := newFakeClient(t)
+	fakeServer.mu.Lock()
+	fakeServer.watchHeartbeat = time.Millisecond * 10
+	fakeServer.mu.Unlock()
+
+	recv := func(stream PartitionService_WatchClient) *ChangeEvent {
+		event, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("could not receive from watch : %v", err)
+		}
+		return event
+	}
+
+	// A watch opened before any change resumes from the start once the position is reported.
+	stream, err := partCl.client.Watch(ctx, &WatchRequest{Resume: true})
+	if err != nil {
+		t.Fatalf("could not watch : %v", err)
+	}
+	if event := recv(stream); event.GetChange() != ChangeType_CHANGE_HEARTBEAT || event.GetSequence() != 0 {
+		t.Errorf("expected a heartbeat at the start, got %v", event)
+	}
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+	if event := recv(stream); event.GetSequence() != 1 || event.GetResource() != ResourceType_RESOURCE_TENANT {
+		t.Errorf("expected the tenant creation, got %v", event)
+	}
+
+	// A watch filtered to a quiet partition keeps learning its position from heartbeats.
+	quiet, err := partCl.client.Watch(ctx, &WatchRequest{PartitionId: "c2f4j7au6s7f91uqnojg"})
+	if err != nil {
+		t.Fatalf("could not watch : %v", err)
+	}
+	if event := recv(quiet); event.GetChange() != ChangeType_CHANGE_HEARTBEAT || event.GetSequence() != 1 {
+		t.Errorf("expected a heartbeat at the current sequence, got %v", event)
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err = partCl.NewPartition(ctx, tenant.GetTenantId(), fmt.Sprintf("branch %d", i), "a branch of the bank", nil)
+		if err != nil {
+			t.Fatalf("could not create partition : %v", err)
+		}
+	}
+
+	for {
+		event := recv(quiet)
+		if event.GetChange() != ChangeType_CHANGE_HEARTBEAT {
+			t.Fatalf("expected only heartbeats on the quiet partition, got %v", event)
+		}
+		if event.GetSequence() == 4 {
+			break
+		}
+	}
+}
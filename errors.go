@@ -0,0 +1,111 @@
+package partitionv1
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors reported by the partition service, match them with errors.Is on any error returned by PartitionClient.
+var (
+	ErrInvalidArgument    = errors.New("partition: invalid argument")
+	ErrOutOfRange         = errors.New("partition: out of range")
+	ErrNotFound           = errors.New("partition: not found")
+	ErrAlreadyExists      = errors.New("partition: already exists")
+	ErrFailedPrecondition = errors.New("partition: failed precondition")
+	ErrPermissionDenied   = errors.New("partition: permission denied")
+	ErrUnauthenticated    = errors.New("partition: unauthenticated")
+	ErrUnavailable        = errors.New("partition: service unavailable")
+	ErrDeadlineExceeded   = errors.New("partition: deadline exceeded")
+	ErrCanceled           = errors.New("partition: canceled")
+)
+
+var codeErrors = map[codes.Code]error{
+	codes.InvalidArgument:    ErrInvalidArgument,
+	codes.OutOfRange:         ErrOutOfRange,
+	codes.NotFound:           ErrNotFound,
+	codes.AlreadyExists:      ErrAlreadyExists,
+	codes.FailedPrecondition: ErrFailedPrecondition,
+	codes.PermissionDenied:   ErrPermissionDenied,
+	codes.Unauthenticated:    ErrUnauthenticated,
+	codes.Unavailable:        ErrUnavailable,
+	codes.DeadlineExceeded:   ErrDeadlineExceeded,
+	codes.Canceled:           ErrCanceled,
+}
+
+// Error is a failure reported by the partition service, it matches the sentinel error of its code
+// with errors.Is and carries the details the service attached to the status.
+type Error struct {
+	Code    codes.Code
+	Message string
+
+	// ResourceType and ResourceName identify the object the failure concerns, when reported by the service.
+	ResourceType string
+	ResourceName string
+
+	// Violations lists the request fields the service rejected.
+	Violations []FieldViolation
+
+	status *status.Status
+}
+
+func (e *Error) Error() string {
+	return e.GRPCStatus().Err().Error()
+}
+
+// Is reports whether target is the sentinel error for the code of e.
+func (e *Error) Is(target error) bool {
+	sentinel, ok := codeErrors[e.Code]
+	return ok && sentinel == target
+}
+
+// GRPCStatus returns the status the service replied with so status.Code keeps working on the error,
+// an error built without one, e.g. by a mock, reports its Code and Message.
+func (e *Error) GRPCStatus() *status.Status {
+	if e.status == nil {
+		return status.New(e.Code, e.Message)
+	}
+	return e.status
+}
+
+// Is reports whether target is ErrInvalidArgument, a request rejected by the client
+// matches the same sentinel as one rejected by the service.
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// toError converts a gRPC status error returned by the service into an *Error,
+// errors that carry no status are returned unchanged.
+func toError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var serviceErr *Error
+	var fieldErr *FieldError
+	if errors.As(err, &serviceErr) || errors.As(err, &fieldErr) {
+		return err
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	e := &Error{Code: st.Code(), Message: st.Message(), status: st}
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ResourceInfo:
+			e.ResourceType = detail.GetResourceType()
+			e.ResourceName = detail.GetResourceName()
+		case *errdetails.BadRequest:
+			for _, violation := range detail.GetFieldViolations() {
+				e.Violations = append(e.Violations,
+					FieldViolation{Field: violation.GetField(), Reason: violation.GetDescription()})
+			}
+		}
+	}
+	return e
+}
@@ -0,0 +1,114 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apic "github.com/antinvestor/apis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceErrors(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	_, err := partCl.GetPartition(ctx, "missingpartition")
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("expected a not found error for a missing partition, got %v", err)
+	}
+
+	var serviceErr *Error
+	if !errors.As(err, &serviceErr) {
+		t.Fatalf("expected a service error, got %T", err)
+	}
+	if serviceErr.ResourceType != "partition" || serviceErr.ResourceName != "missingpartition" {
+		t.Errorf("expected the missing partition to be identified, got %s %s", serviceErr.ResourceType, serviceErr.ResourceName)
+	}
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected the status code to be kept, got %v", status.Code(err))
+	}
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	partition, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	access, err := partCl.CreateAccess(ctx, partition.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not create access : %v", err)
+	}
+
+	_, err = partCl.CreateAccess(ctx, partition.GetPartitionId(), "profile1")
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("expected an already exists error for duplicate access, got %v", err)
+	}
+	if errors.As(err, &serviceErr) && serviceErr.ResourceName != access.GetAccessId() {
+		t.Errorf("expected the existing access %s to be identified, got %s", access.GetAccessId(), serviceErr.ResourceName)
+	}
+
+	_, err = partCl.SuspendTenant(ctx, tenant.GetTenantId())
+	if err != nil {
+		t.Fatalf("could not suspend tenant : %v", err)
+	}
+
+	_, err = partCl.NewPartition(ctx, tenant.GetTenantId(), "branch", "a branch of the bank", nil)
+	if !errors.Is(err, ErrFailedPrecondition) {
+		t.Errorf("expected a failed precondition error for a suspended tenant, got %v", err)
+	}
+}
+
+func TestInvalidArgumentErrors(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		opts []apic.ClientOption
+	}{
+		{name: "rejected by the client"},
+		{name: "rejected by the service", opts: []apic.ClientOption{WithoutValidation()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeServer := NewFakePartitionServiceServer()
+			t.Cleanup(fakeServer.Stop)
+
+			partCl, err := NewFakePartitionsClient(ctx, fakeServer, tt.opts...)
+			if err != nil {
+				t.Fatalf("could not connect to fake partition service : %v", err)
+			}
+			t.Cleanup(func() { _ = partCl.Close() })
+
+			_, err = partCl.NewTenant(ctx, "bk", "short", nil)
+			if !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("expected an invalid argument error, got %v", err)
+			}
+
+			violations := FieldViolations(err)
+			if len(violations) != 2 || violations[0].Field != "Name" || violations[1].Field != "Description" {
+				t.Errorf("expected the name and description violations, got %v", violations)
+			}
+		})
+	}
+}
+
+func TestErrorLiteral(t *testing.T) {
+	var err error = &Error{Code: codes.NotFound, Message: "partition x not found"}
+
+	if err.Error() != "rpc error: code = NotFound desc = partition x not found" {
+		t.Errorf("expected the code and message to be reported, got %s", err.Error())
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the error to match its sentinel, got %v", err)
+	}
+	if status.Code(err) != codes.NotFound || status.Convert(err).Message() != "partition x not found" {
+		t.Errorf("expected the status to be built from the code and message, got %v", status.Convert(err))
+	}
+}
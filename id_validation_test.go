@@ -0,0 +1,90 @@
+package partitionv1
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIdValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		id    string
+		valid bool
+	}{
+		{name: "xid", id: "c9ub0ro0g5v1jd3rqrg0", valid: true},
+		{name: "uuid", id: "0f8fad5b-d9cb-469f-a165-70867728950e", valid: true},
+		{name: "readable id", id: "bank_branch-1", valid: true},
+		{name: "shortest id", id: "abc", valid: true},
+		{name: "longest id", id: strings.Repeat("a", 40), valid: true},
+		{name: "too short", id: "ab"},
+		{name: "too long", id: strings.Repeat("a", 41)},
+		{name: "uppercase uuid", id: "0F8FAD5B-D9CB-469F-A165-70867728950E"},
+		{name: "valid prefix", id: "abc!"},
+		{name: "valid suffix", id: "!abc"},
+		{name: "embedded space", id: "bank branch"},
+		{name: "trailing newline", id: "bank\n"},
+		{name: "mostly invalid", id: "abc" + strings.Repeat("$", 37)},
+	}
+
+	messages := []struct {
+		name    string
+		request func(id string) validator
+	}{
+		{name: "GetRequest.Id", request: func(id string) validator {
+			return &GetRequest{Id: id}
+		}},
+		{name: "PartitionCreateRequest.TenantId", request: func(id string) validator {
+			return &PartitionCreateRequest{TenantId: id, Name: "bank", Description: "the bank partition"}
+		}},
+		{name: "PartitionCreateRequest.ParentId", request: func(id string) validator {
+			return &PartitionCreateRequest{TenantId: "tenant1", ParentId: id, Name: "bank", Description: "the bank partition"}
+		}},
+		{name: "PartitionMoveRequest.TenantId", request: func(id string) validator {
+			return &PartitionMoveRequest{PartitionId: "partition1", TenantId: id}
+		}},
+		{name: "PageGetRequest.PartitionId", request: func(id string) validator {
+			return &PageGetRequest{PartitionId: id, Name: "signup"}
+		}},
+		{name: "AccessCreateRequest.ProfileId", request: func(id string) validator {
+			return &AccessCreateRequest{PartitionId: "partition1", ProfileId: id}
+		}},
+		{name: "AccessRoleCreateRequest.PartitionRoleId", request: func(id string) validator {
+			return &AccessRoleCreateRequest{AccessId: "access1", PartitionRoleId: id}
+		}},
+		{name: "PageRenderResponse.PagePartitionId", request: func(id string) validator {
+			return &PageRenderResponse{Html: "<p>signup</p>", PageId: "page1", PagePartitionId: id, Version: 1}
+		}},
+		{name: "AccessCheckResponse.AccessRoleId", request: func(id string) validator {
+			return &AccessCheckResponse{Allowed: true, AccessId: "access1", AccessRoleId: id, PartitionId: "partition1"}
+		}},
+		{name: "ChangeEvent.PartitionId", request: func(id string) validator {
+			return &ChangeEvent{Sequence: 1, Id: "partition1", TenantId: "tenant1", PartitionId: id}
+		}},
+	}
+
+	for _, msg := range messages {
+		for _, tt := range tests {
+			t.Run(msg.name+"/"+tt.name, func(t *testing.T) {
+				err := msg.request(tt.id).ValidateAll()
+				if tt.valid && err != nil {
+					t.Errorf("expected %q to be accepted, got %v", tt.id, err)
+				}
+				if !tt.valid && err == nil {
+					t.Errorf("expected %q to be rejected", tt.id)
+				}
+			})
+		}
+	}
+}
+
+func TestRootPartitionValidation(t *testing.T) {
+	request := &PartitionCreateRequest{TenantId: "tenant1", Name: "bank", Description: "the bank partition"}
+	if err := request.ValidateAll(); err != nil {
+		t.Errorf("expected a root partition request without a parent to be accepted, got %v", err)
+	}
+
+	partition := &PartitionObject{PartitionId: "partition1", TenantId: "tenant1", Name: "bank", Description: "the bank partition"}
+	if err := partition.ValidateAll(); err != nil {
+		t.Errorf("expected a root partition without a parent to be accepted, got %v", err)
+	}
+}
@@ -0,0 +1,195 @@
+package partitionv1
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+)
+
+// The html of a page created as a template may reference variables of the partition it is rendered for,
+// placed between double braces e.g. <h1>Welcome to {{ name }}</h1>. The language has no logic and every value
+// is escaped for where it is placed, as html/template does: text is html escaped, a javascript: url in an href
+// renders as #ZgotmplZ and a value inside a <script> becomes a quoted string, so tenants can be trusted to edit
+// their own pages. As with html/template, html comments are dropped from the rendered page. The variables are
+//
+//	{{ id }}, {{ name }}, {{ description }}  of the partition the page is rendered for
+//	{{ properties.KEY }}                      the property KEY of the partition, or of its closest ancestor having it
+//	{{ parent.name }}                         any of the above for the parent, parent.parent.name for the grandparent
+//
+// Variables of partitions above the root and missing properties render empty. Literal braces, e.g. those of
+// a Vue or Angular template embedded in the page, are written {{{{ which renders as {{ and leaves the text
+// following it alone. Pages not created as templates are served as they are.
+const (
+	templateOpen       = "{{"
+	templateLiteral    = "{{{{"
+	templateClose      = "}}"
+	templateParent     = "parent."
+	templateProperties = "properties."
+
+	// compiledOpen and compiledClose delimit the actions of the compiled html/template, page templates may not
+	// contain a NUL so the delimiters never clash with the page html.
+	compiledOpen  = "\x00{"
+	compiledClose = "}\x00"
+)
+
+// templateVariable is a variable referenced by a page template.
+type templateVariable struct {
+	// depth is the number of parents to go up from the partition the page is rendered for.
+	depth int
+	// field is one of id, name, description or properties, key names the property for the latter.
+	field string
+	key   string
+}
+
+// templateSegment is either literal text or a variable of a page template.
+type templateSegment struct {
+	text     string
+	variable *templateVariable
+}
+
+func parsePageTemplate(template string) ([]templateSegment, error) {
+	if strings.ContainsRune(template, 0) {
+		return nil, fmt.Errorf("page template : contains a NUL character")
+	}
+
+	var segments []templateSegment
+	for {
+		start := strings.Index(template, templateOpen)
+		if start < 0 {
+			if template != "" {
+				segments = append(segments, templateSegment{text: template})
+			}
+			return segments, nil
+		}
+		if start > 0 {
+			segments = append(segments, templateSegment{text: template[:start]})
+		}
+
+		if strings.HasPrefix(template[start:], templateLiteral) {
+			segments = append(segments, templateSegment{text: templateOpen})
+			template = template[start+len(templateLiteral):]
+			continue
+		}
+
+		rest := template[start+len(templateOpen):]
+		end := strings.Index(rest, templateClose)
+		if end < 0 {
+			return nil, fmt.Errorf("page template : variable at offset %d is not closed", start)
+		}
+
+		variable, err := parseTemplateVariable(strings.TrimSpace(rest[:end]))
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, templateSegment{variable: variable})
+		template = rest[end+len(templateClose):]
+	}
+}
+
+func parseTemplateVariable(name string) (*templateVariable, error) {
+	variable := &templateVariable{}
+	path := name
+	for strings.HasPrefix(path, templateParent) {
+		variable.depth++
+		path = strings.TrimPrefix(path, templateParent)
+	}
+
+	switch {
+	case path == "id" || path == "name" || path == "description":
+		variable.field = path
+	case strings.HasPrefix(path, templateProperties) && len(path) > len(templateProperties):
+		variable.field = "properties"
+		variable.key = strings.TrimPrefix(path, templateProperties)
+	default:
+		return nil, fmt.Errorf("page template : unknown variable %q", name)
+	}
+	return variable, nil
+}
+
+// resolve finds the value of the variable, chain holds the partition the page is rendered for followed by
+// its ancestors up to the root.
+func (variable *templateVariable) resolve(chain []*PartitionObject) string {
+	if variable.depth >= len(chain) {
+		return ""
+	}
+
+	partition := chain[variable.depth]
+	switch variable.field {
+	case "id":
+		return partition.GetPartitionId()
+	case "name":
+		return partition.GetName()
+	case "description":
+		return partition.GetDescription()
+	}
+
+	for _, ancestor := range chain[variable.depth:] {
+		if value, ok := ancestor.GetProperties()[variable.key]; ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// compilePageTemplate turns the page html into an html/template, the variables become actions indexing the
+// values passed on execution in the order they appear.
+func compilePageTemplate(template string) (*htmltemplate.Template, []*templateVariable, error) {
+	segments, err := parsePageTemplate(template)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var source strings.Builder
+	var variables []*templateVariable
+	for _, segment := range segments {
+		if segment.variable == nil {
+			source.WriteString(segment.text)
+			continue
+		}
+		fmt.Fprintf(&source, "%sindex . %d%s", compiledOpen, len(variables), compiledClose)
+		variables = append(variables, segment.variable)
+	}
+
+	compiled, err := htmltemplate.New("page").Delims(compiledOpen, compiledClose).Parse(source.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("page template : %v", err)
+	}
+	return compiled, variables, nil
+}
+
+// ValidatePageTemplate checks that every variable referenced by the page html is closed and known, and that
+// the html leaves no variable in a place it can not be escaped for.
+func ValidatePageTemplate(template string) error {
+	compiled, variables, err := compilePageTemplate(template)
+	if err != nil {
+		return err
+	}
+
+	// html/template works out the escaping on the first execution, so its errors only surface then.
+	if err = compiled.Execute(io.Discard, make([]string, len(variables))); err != nil {
+		return fmt.Errorf("page template : %v", err)
+	}
+	return nil
+}
+
+// RenderPageTemplate replaces the variables of the page html with their values escaped for where they are
+// placed, chain holds the partition the page is rendered for followed by its ancestors from its parent up to
+// the root.
+func RenderPageTemplate(template string, chain []*PartitionObject) (string, error) {
+	compiled, variables, err := compilePageTemplate(template)
+	if err != nil {
+		return "", err
+	}
+
+	values := make([]string, len(variables))
+	for i, variable := range variables {
+		values[i] = variable.resolve(chain)
+	}
+
+	var rendered strings.Builder
+	if err = compiled.Execute(&rendered, values); err != nil {
+		return "", fmt.Errorf("page template : %v", err)
+	}
+	return rendered.String(), nil
+}
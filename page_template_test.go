@@ -0,0 +1,54 @@
+package partitionv1
+
+import (
+	"testing"
+)
+
+func TestRenderPageTemplate(t *testing.T) {
+	chain := []*PartitionObject{
+		{PartitionId: "branch", Name: "Kampala <central>", Description: "the central branch",
+			Properties: map[string]string{"phone": "0700", "url": "javascript:alert(1)", "motto": `"</script><b>`}},
+		{PartitionId: "region", Name: "Uganda", Properties: map[string]string{"color": "green", "phone": "0800"}},
+		{PartitionId: "bank", Name: "Bank", Properties: map[string]string{"logo": "bank.png"}},
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		rendered string
+		wantErr  bool
+	}{
+		{"static html", "<p>welcome</p>", "<p>welcome</p>", false},
+		{"partition fields", "<h1>{{ name }}</h1>{{id}} {{ description }}", "<h1>Kampala &lt;central&gt;</h1>branch the central branch", false},
+		{"own property", "call {{ properties.phone }}", "call 0700", false},
+		{"inherited property", "{{ properties.color }} {{ properties.logo }}", "green bank.png", false},
+		{"missing property", "[{{ properties.fax }}]", "[]", false},
+		{"parent chain", "{{ parent.name }} / {{ parent.parent.name }}", "Uganda / Bank", false},
+		{"parent property", "{{ parent.properties.phone }}", "0800", false},
+		{"above the root", "[{{ parent.parent.parent.name }}]", "[]", false},
+		{"literal braces", "<p>{{{{ form.email }}</p>{{ name }}", "<p>{{ form.email }}</p>Kampala &lt;central&gt;", false},
+		{"script url", `<a href="{{ properties.url }}">home</a>`, `<a href="#ZgotmplZ">home</a>`, false},
+		{"script value", `<script>var motto = {{ properties.motto }};</script>`,
+			`<script>var motto = "\"\u003c/script\u003e\u003cb\u003e";</script>`, false},
+		{"unescapable place", `<a href="{{ name }}`, "", true},
+		{"unknown variable", "{{ secret }}", "", true},
+		{"unclosed variable", "<p>{{ name </p>", "", true},
+		{"empty property key", "{{ properties. }}", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidatePageTemplate(tt.template); (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePageTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			rendered, err := RenderPageTemplate(tt.template, chain)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderPageTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if rendered != tt.rendered {
+				t.Errorf("RenderPageTemplate() = %q, want %q", rendered, tt.rendered)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net/mail"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -33,29 +34,59 @@ var (
 	_ = (*url.URL)(nil)
 	_ = (*mail.Address)(nil)
 	_ = anypb.Any{}
-
-	_ = common.STATE(0)
-
-	_ = common.STATE(0)
-
-	_ = common.STATE(0)
+	_ = sort.Sort
 
 	_ = common.STATE(0)
 )
 
 // Validate checks the field values on RemoveResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
 func (m *RemoveResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RemoveResponseMultiError,
+// or nil if none found.
+func (m *RemoveResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	// no validation rules for Succeeded
 
+	if len(errors) > 0 {
+		return RemoveResponseMultiError(errors)
+	}
+
 	return nil
 }
 
+// RemoveResponseMultiError is an error wrapping multiple validation errors
+// returned by RemoveResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RemoveResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveResponseMultiError) AllErrors() []error { return m }
+
 // RemoveResponseValidationError is the validation error returned by
 // RemoveResponse.Validate if the designated constraints aren't met.
 type RemoveResponseValidationError struct {
@@ -111,32 +142,75 @@ var _ interface {
 } = RemoveResponseValidationError{}
 
 // Validate checks the field values on TenantRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
 func (m *TenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantRequestMultiError, or
+// nil if none found.
+func (m *TenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-		return TenantRequestValidationError{
+		err := TenantRequestValidationError{
 			field:  "Name",
 			reason: "value length must be between 3 and 100 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetDescription()); l < 10 || l > 500 {
-		return TenantRequestValidationError{
+		err := TenantRequestValidationError{
 			field:  "Description",
 			reason: "value length must be between 10 and 500 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	// no validation rules for Properties
 
+	if len(errors) > 0 {
+		return TenantRequestMultiError(errors)
+	}
+
 	return nil
 }
 
+// TenantRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TenantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantRequestMultiError) AllErrors() []error { return m }
+
 // TenantRequestValidationError is the validation error returned by
 // TenantRequest.Validate if the designated constraints aren't met.
 type TenantRequestValidationError struct {
@@ -192,39 +266,156 @@ var _ interface {
 } = TenantRequestValidationError{}
 
 // Validate checks the field values on TenantObject with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
 func (m *TenantObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantObject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantObjectMultiError, or
+// nil if none found.
+func (m *TenantObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantObject) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetTenantId()); l < 3 || l > 40 {
-		return TenantObjectValidationError{
+		err := TenantObjectValidationError{
 			field:  "TenantId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if !_TenantObject_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		return TenantObjectValidationError{
+		err := TenantObjectValidationError{
 			field:  "TenantId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetDescription()); l < 10 || l > 500 {
-		return TenantObjectValidationError{
+		err := TenantObjectValidationError{
 			field:  "Description",
 			reason: "value length must be between 10 and 500 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	// no validation rules for Properties
 
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := TenantObjectValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantObjectValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetModifiedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModifiedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantObjectValidationError{
+				field:  "ModifiedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TenantObjectMultiError(errors)
+	}
+
 	return nil
 }
 
+// TenantObjectMultiError is an error wrapping multiple validation errors
+// returned by TenantObject.ValidateAll() if the designated constraints aren't met.
+type TenantObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantObjectMultiError) AllErrors() []error { return m }
+
 // TenantObjectValidationError is the validation error returned by
 // TenantObject.Validate if the designated constraints aren't met.
 type TenantObjectValidationError struct {
@@ -279,63 +470,278 @@ var _ interface {
 	ErrorName() string
 } = TenantObjectValidationError{}
 
-var _TenantObject_TenantId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _TenantObject_TenantId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on TenantUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantUpdateRequestMultiError, or nil if none found.
+func (m *TenantUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTenantId()); l < 3 || l > 40 {
+		err := TenantUpdateRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TenantUpdateRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := TenantUpdateRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := TenantUpdateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetDescription()); l < 10 || l > 500 {
+		err := TenantUpdateRequestValidationError{
+			field:  "Description",
+			reason: "value length must be between 10 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for State
+
+	// no validation rules for Properties
+
+	if len(errors) > 0 {
+		return TenantUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantUpdateRequestMultiError) AllErrors() []error { return m }
+
+// TenantUpdateRequestValidationError is the validation error returned by
+// TenantUpdateRequest.Validate if the designated constraints aren't met.
+type TenantUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantUpdateRequestValidationError) ErrorName() string {
+	return "TenantUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantUpdateRequestValidationError{}
+
+var _TenantUpdateRequest_TenantId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
 // Validate checks the field values on PartitionCreateRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
+// violated, the first error encountered is returned, or nil if there are no violations.
 func (m *PartitionCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionCreateRequestMultiError, or nil if none found.
+func (m *PartitionCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-		return PartitionCreateRequestValidationError{
+		err := PartitionCreateRequestValidationError{
 			field:  "Name",
 			reason: "value length must be between 3 and 100 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetTenantId()); l < 3 || l > 40 {
-		return PartitionCreateRequestValidationError{
+		err := PartitionCreateRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if !_PartitionCreateRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		return PartitionCreateRequestValidationError{
+		err := PartitionCreateRequestValidationError{
 			field:  "TenantId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetParentId()); l < 3 || l > 40 {
-		return PartitionCreateRequestValidationError{
-			field:  "ParentId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
+	if m.GetParentId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetParentId()); l < 3 || l > 40 {
+			err := PartitionCreateRequestValidationError{
+				field:  "ParentId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
 
-	if !_PartitionCreateRequest_ParentId_Pattern.MatchString(m.GetParentId()) {
-		return PartitionCreateRequestValidationError{
-			field:  "ParentId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+		if !_PartitionCreateRequest_ParentId_Pattern.MatchString(m.GetParentId()) {
+			err := PartitionCreateRequestValidationError{
+				field:  "ParentId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if l := utf8.RuneCountInString(m.GetDescription()); l < 10 || l > 250 {
-		return PartitionCreateRequestValidationError{
+		err := PartitionCreateRequestValidationError{
 			field:  "Description",
 			reason: "value length must be between 10 and 250 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	// no validation rules for Properties
 
+	if len(errors) > 0 {
+		return PartitionCreateRequestMultiError(errors)
+	}
+
 	return nil
 }
 
+// PartitionCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by PartitionCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PartitionCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionCreateRequestMultiError) AllErrors() []error { return m }
+
 // PartitionCreateRequestValidationError is the validation error returned by
 // PartitionCreateRequest.Validate if the designated constraints aren't met.
 type PartitionCreateRequestValidationError struct {
@@ -392,34 +798,77 @@ var _ interface {
 	ErrorName() string
 } = PartitionCreateRequestValidationError{}
 
-var _PartitionCreateRequest_TenantId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionCreateRequest_TenantId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-var _PartitionCreateRequest_ParentId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionCreateRequest_ParentId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
 // Validate checks the field values on GetRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
 func (m *GetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRequestMultiError, or
+// nil if none found.
+func (m *GetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetId()); l < 3 || l > 40 {
-		return GetRequestValidationError{
+		err := GetRequestValidationError{
 			field:  "Id",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if !_GetRequest_Id_Pattern.MatchString(m.GetId()) {
-		return GetRequestValidationError{
+		err := GetRequestValidationError{
 			field:  "Id",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRequestMultiError(errors)
 	}
 
 	return nil
 }
 
+// GetRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRequest.ValidateAll() if the designated constraints aren't met.
+type GetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRequestMultiError) AllErrors() []error { return m }
+
 // GetRequestValidationError is the validation error returned by
 // GetRequest.Validate if the designated constraints aren't met.
 type GetRequestValidationError struct {
@@ -474,51 +923,102 @@ var _ interface {
 	ErrorName() string
 } = GetRequestValidationError{}
 
-var _GetRequest_Id_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _GetRequest_Id_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
 // Validate checks the field values on PartitionUpdateRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
+// violated, the first error encountered is returned, or nil if there are no violations.
 func (m *PartitionUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionUpdateRequestMultiError, or nil if none found.
+func (m *PartitionUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
-		return PartitionUpdateRequestValidationError{
+		err := PartitionUpdateRequestValidationError{
 			field:  "PartitionId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if !_PartitionUpdateRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
-		return PartitionUpdateRequestValidationError{
+		err := PartitionUpdateRequestValidationError{
 			field:  "PartitionId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-		return PartitionUpdateRequestValidationError{
+		err := PartitionUpdateRequestValidationError{
 			field:  "Name",
 			reason: "value length must be between 3 and 100 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetDescription()); l < 10 || l > 500 {
-		return PartitionUpdateRequestValidationError{
+		err := PartitionUpdateRequestValidationError{
 			field:  "Description",
 			reason: "value length must be between 10 and 500 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	// no validation rules for State
 
 	// no validation rules for Properties
 
+	if len(errors) > 0 {
+		return PartitionUpdateRequestMultiError(errors)
+	}
+
 	return nil
 }
 
+// PartitionUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by PartitionUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PartitionUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionUpdateRequestMultiError) AllErrors() []error { return m }
+
 // PartitionUpdateRequestValidationError is the validation error returned by
 // PartitionUpdateRequest.Validate if the designated constraints aren't met.
 type PartitionUpdateRequestValidationError struct {
@@ -575,79 +1075,208 @@ var _ interface {
 	ErrorName() string
 } = PartitionUpdateRequestValidationError{}
 
-var _PartitionUpdateRequest_PartitionId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionUpdateRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
 // Validate checks the field values on PartitionObject with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
 func (m *PartitionObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionObject with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionObjectMultiError, or nil if none found.
+func (m *PartitionObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionObject) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
-		return PartitionObjectValidationError{
+		err := PartitionObjectValidationError{
 			field:  "PartitionId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if !_PartitionObject_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
-		return PartitionObjectValidationError{
+		err := PartitionObjectValidationError{
 			field:  "PartitionId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-		return PartitionObjectValidationError{
+		err := PartitionObjectValidationError{
 			field:  "Name",
 			reason: "value length must be between 3 and 100 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetTenantId()); l < 3 || l > 40 {
-		return PartitionObjectValidationError{
+		err := PartitionObjectValidationError{
 			field:  "TenantId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if !_PartitionObject_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		return PartitionObjectValidationError{
+		err := PartitionObjectValidationError{
 			field:  "TenantId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
 		}
-	}
-
-	if l := utf8.RuneCountInString(m.GetParentId()); l < 3 || l > 40 {
-		return PartitionObjectValidationError{
-			field:  "ParentId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if !_PartitionObject_ParentId_Pattern.MatchString(m.GetParentId()) {
-		return PartitionObjectValidationError{
-			field:  "ParentId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+	if m.GetParentId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetParentId()); l < 3 || l > 40 {
+			err := PartitionObjectValidationError{
+				field:  "ParentId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
+
+		if !_PartitionObject_ParentId_Pattern.MatchString(m.GetParentId()) {
+			err := PartitionObjectValidationError{
+				field:  "ParentId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if l := utf8.RuneCountInString(m.GetDescription()); l < 10 || l > 500 {
-		return PartitionObjectValidationError{
+		err := PartitionObjectValidationError{
 			field:  "Description",
 			reason: "value length must be between 10 and 500 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	// no validation rules for State
 
 	// no validation rules for Properties
 
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartitionObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartitionObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartitionObjectValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetModifiedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartitionObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartitionObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModifiedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartitionObjectValidationError{
+				field:  "ModifiedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PartitionObjectMultiError(errors)
+	}
+
 	return nil
 }
 
+// PartitionObjectMultiError is an error wrapping multiple validation errors
+// returned by PartitionObject.ValidateAll() if the designated constraints
+// aren't met.
+type PartitionObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionObjectMultiError) AllErrors() []error { return m }
+
 // PartitionObjectValidationError is the validation error returned by
 // PartitionObject.Validate if the designated constraints aren't met.
 type PartitionObjectValidationError struct {
@@ -702,49 +1331,137 @@ var _ interface {
 	ErrorName() string
 } = PartitionObjectValidationError{}
 
-var _PartitionObject_PartitionId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionObject_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-var _PartitionObject_TenantId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionObject_TenantId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-var _PartitionObject_ParentId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionObject_ParentId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on PartitionRoleCreateRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *PartitionRoleCreateRequest) Validate() error {
+// Validate checks the field values on PartitionMoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PartitionMoveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionMoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionMoveRequestMultiError, or nil if none found.
+func (m *PartitionMoveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionMoveRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
-		return PartitionRoleCreateRequestValidationError{
+		err := PartitionMoveRequestValidationError{
 			field:  "PartitionId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if !_PartitionRoleCreateRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
-		return PartitionRoleCreateRequestValidationError{
+	if !_PartitionMoveRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := PartitionMoveRequestValidationError{
 			field:  "PartitionId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-		return PartitionRoleCreateRequestValidationError{
-			field:  "Name",
-			reason: "value length must be between 3 and 100 runes, inclusive",
+	if m.GetParentId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetParentId()); l < 3 || l > 40 {
+			err := PartitionMoveRequestValidationError{
+				field:  "ParentId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
+		if !_PartitionMoveRequest_ParentId_Pattern.MatchString(m.GetParentId()) {
+			err := PartitionMoveRequestValidationError{
+				field:  "ParentId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	}
 
-	// no validation rules for Properties
+	if m.GetTenantId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetTenantId()); l < 3 || l > 40 {
+			err := PartitionMoveRequestValidationError{
+				field:  "TenantId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PartitionMoveRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+			err := PartitionMoveRequestValidationError{
+				field:  "TenantId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for IncludeSubtree
+
+	if len(errors) > 0 {
+		return PartitionMoveRequestMultiError(errors)
+	}
 
 	return nil
 }
 
-// PartitionRoleCreateRequestValidationError is the validation error returned
-// by PartitionRoleCreateRequest.Validate if the designated constraints aren't met.
-type PartitionRoleCreateRequestValidationError struct {
+// PartitionMoveRequestMultiError is an error wrapping multiple validation
+// errors returned by PartitionMoveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PartitionMoveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionMoveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionMoveRequestMultiError) AllErrors() []error { return m }
+
+// PartitionMoveRequestValidationError is the validation error returned by
+// PartitionMoveRequest.Validate if the designated constraints aren't met.
+type PartitionMoveRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -752,24 +1469,24 @@ type PartitionRoleCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PartitionRoleCreateRequestValidationError) Field() string { return e.field }
+func (e PartitionMoveRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PartitionRoleCreateRequestValidationError) Reason() string { return e.reason }
+func (e PartitionMoveRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PartitionRoleCreateRequestValidationError) Cause() error { return e.cause }
+func (e PartitionMoveRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PartitionRoleCreateRequestValidationError) Key() bool { return e.key }
+func (e PartitionMoveRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PartitionRoleCreateRequestValidationError) ErrorName() string {
-	return "PartitionRoleCreateRequestValidationError"
+func (e PartitionMoveRequestValidationError) ErrorName() string {
+	return "PartitionMoveRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PartitionRoleCreateRequestValidationError) Error() string {
+func (e PartitionMoveRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -781,14 +1498,14 @@ func (e PartitionRoleCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPartitionRoleCreateRequest.%s: %s%s",
+		"invalid %sPartitionMoveRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PartitionRoleCreateRequestValidationError{}
+var _ error = PartitionMoveRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -796,61 +1513,97 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PartitionRoleCreateRequestValidationError{}
+} = PartitionMoveRequestValidationError{}
 
-var _PartitionRoleCreateRequest_PartitionId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionMoveRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on PartitionRoleObject with the rules
+var _PartitionMoveRequest_ParentId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _PartitionMoveRequest_TenantId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on PartitionListResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *PartitionRoleObject) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PartitionListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionListResponseMultiError, or nil if none found.
+func (m *PartitionListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetPartitionRoleId()); l < 3 || l > 40 {
-		return PartitionRoleObjectValidationError{
-			field:  "PartitionRoleId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
-		}
-	}
+	var errors []error
 
-	if !_PartitionRoleObject_PartitionRoleId_Pattern.MatchString(m.GetPartitionRoleId()) {
-		return PartitionRoleObjectValidationError{
-			field:  "PartitionRoleId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-		}
-	}
+	for idx, item := range m.GetPartition() {
+		_, _ = idx, item
 
-	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
-		return PartitionRoleObjectValidationError{
-			field:  "PartitionId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PartitionListResponseValidationError{
+						field:  fmt.Sprintf("Partition[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PartitionListResponseValidationError{
+						field:  fmt.Sprintf("Partition[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PartitionListResponseValidationError{
+					field:  fmt.Sprintf("Partition[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-	}
 
-	if !_PartitionRoleObject_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
-		return PartitionRoleObjectValidationError{
-			field:  "PartitionId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-		}
 	}
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-		return PartitionRoleObjectValidationError{
-			field:  "Name",
-			reason: "value length must be between 3 and 100 runes, inclusive",
-		}
+	if len(errors) > 0 {
+		return PartitionListResponseMultiError(errors)
 	}
 
-	// no validation rules for Properties
-
 	return nil
 }
 
-// PartitionRoleObjectValidationError is the validation error returned by
-// PartitionRoleObject.Validate if the designated constraints aren't met.
-type PartitionRoleObjectValidationError struct {
+// PartitionListResponseMultiError is an error wrapping multiple validation
+// errors returned by PartitionListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PartitionListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionListResponseMultiError) AllErrors() []error { return m }
+
+// PartitionListResponseValidationError is the validation error returned by
+// PartitionListResponse.Validate if the designated constraints aren't met.
+type PartitionListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -858,24 +1611,24 @@ type PartitionRoleObjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e PartitionRoleObjectValidationError) Field() string { return e.field }
+func (e PartitionListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PartitionRoleObjectValidationError) Reason() string { return e.reason }
+func (e PartitionListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PartitionRoleObjectValidationError) Cause() error { return e.cause }
+func (e PartitionListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PartitionRoleObjectValidationError) Key() bool { return e.key }
+func (e PartitionListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PartitionRoleObjectValidationError) ErrorName() string {
-	return "PartitionRoleObjectValidationError"
+func (e PartitionListResponseValidationError) ErrorName() string {
+	return "PartitionListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PartitionRoleObjectValidationError) Error() string {
+func (e PartitionListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -887,14 +1640,14 @@ func (e PartitionRoleObjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPartitionRoleObject.%s: %s%s",
+		"invalid %sPartitionListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PartitionRoleObjectValidationError{}
+var _ error = PartitionListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -902,40 +1655,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PartitionRoleObjectValidationError{}
+} = PartitionListResponseValidationError{}
 
-var _PartitionRoleObject_PartitionRoleId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
-
-var _PartitionRoleObject_PartitionId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+// Validate checks the field values on PartitionSubtreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PartitionSubtreeRequest) Validate() error {
+	return m.validate(false)
+}
 
-// Validate checks the field values on PartitionRoleRemoveRequest with the
+// ValidateAll checks the field values on PartitionSubtreeRequest with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *PartitionRoleRemoveRequest) Validate() error {
+// violated, the result is a list of violation errors wrapped in
+// PartitionSubtreeRequestMultiError, or nil if none found.
+func (m *PartitionSubtreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionSubtreeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetPartitionRoleId()); l < 3 || l > 40 {
-		return PartitionRoleRemoveRequestValidationError{
-			field:  "PartitionRoleId",
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+		err := PartitionSubtreeRequestValidationError{
+			field:  "PartitionId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if !_PartitionRoleRemoveRequest_PartitionRoleId_Pattern.MatchString(m.GetPartitionRoleId()) {
-		return PartitionRoleRemoveRequestValidationError{
-			field:  "PartitionRoleId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+	if !_PartitionSubtreeRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := PartitionSubtreeRequestValidationError{
+			field:  "PartitionId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDepth() > 50 {
+		err := PartitionSubtreeRequestValidationError{
+			field:  "Depth",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PartitionSubtreeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PartitionRoleRemoveRequestValidationError is the validation error returned
-// by PartitionRoleRemoveRequest.Validate if the designated constraints aren't met.
-type PartitionRoleRemoveRequestValidationError struct {
+// PartitionSubtreeRequestMultiError is an error wrapping multiple validation
+// errors returned by PartitionSubtreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PartitionSubtreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionSubtreeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionSubtreeRequestMultiError) AllErrors() []error { return m }
+
+// PartitionSubtreeRequestValidationError is the validation error returned by
+// PartitionSubtreeRequest.Validate if the designated constraints aren't met.
+type PartitionSubtreeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -943,24 +1746,24 @@ type PartitionRoleRemoveRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PartitionRoleRemoveRequestValidationError) Field() string { return e.field }
+func (e PartitionSubtreeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PartitionRoleRemoveRequestValidationError) Reason() string { return e.reason }
+func (e PartitionSubtreeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PartitionRoleRemoveRequestValidationError) Cause() error { return e.cause }
+func (e PartitionSubtreeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PartitionRoleRemoveRequestValidationError) Key() bool { return e.key }
+func (e PartitionSubtreeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PartitionRoleRemoveRequestValidationError) ErrorName() string {
-	return "PartitionRoleRemoveRequestValidationError"
+func (e PartitionSubtreeRequestValidationError) ErrorName() string {
+	return "PartitionSubtreeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PartitionRoleRemoveRequestValidationError) Error() string {
+func (e PartitionSubtreeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -972,14 +1775,14 @@ func (e PartitionRoleRemoveRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPartitionRoleRemoveRequest.%s: %s%s",
+		"invalid %sPartitionSubtreeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PartitionRoleRemoveRequestValidationError{}
+var _ error = PartitionSubtreeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -987,38 +1790,96 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PartitionRoleRemoveRequestValidationError{}
+} = PartitionSubtreeRequestValidationError{}
 
-var _PartitionRoleRemoveRequest_PartitionRoleId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionSubtreeRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on PartitionRoleListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *PartitionRoleListRequest) Validate() error {
+// Validate checks the field values on PartitionRoleCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PartitionRoleCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionRoleCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionRoleCreateRequestMultiError, or nil if none found.
+func (m *PartitionRoleCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionRoleCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
-		return PartitionRoleListRequestValidationError{
+		err := PartitionRoleCreateRequestValidationError{
 			field:  "PartitionId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if !_PartitionRoleListRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
-		return PartitionRoleListRequestValidationError{
+	if !_PartitionRoleCreateRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := PartitionRoleCreateRequestValidationError{
 			field:  "PartitionId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := PartitionRoleCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Properties
+
+	// no validation rules for NonInheritable
+
+	if len(errors) > 0 {
+		return PartitionRoleCreateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PartitionRoleListRequestValidationError is the validation error returned by
-// PartitionRoleListRequest.Validate if the designated constraints aren't met.
-type PartitionRoleListRequestValidationError struct {
+// PartitionRoleCreateRequestMultiError is an error wrapping multiple
+// validation errors returned by PartitionRoleCreateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PartitionRoleCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionRoleCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionRoleCreateRequestMultiError) AllErrors() []error { return m }
+
+// PartitionRoleCreateRequestValidationError is the validation error returned
+// by PartitionRoleCreateRequest.Validate if the designated constraints aren't met.
+type PartitionRoleCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1026,24 +1887,24 @@ type PartitionRoleListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PartitionRoleListRequestValidationError) Field() string { return e.field }
+func (e PartitionRoleCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PartitionRoleListRequestValidationError) Reason() string { return e.reason }
+func (e PartitionRoleCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PartitionRoleListRequestValidationError) Cause() error { return e.cause }
+func (e PartitionRoleCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PartitionRoleListRequestValidationError) Key() bool { return e.key }
+func (e PartitionRoleCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PartitionRoleListRequestValidationError) ErrorName() string {
-	return "PartitionRoleListRequestValidationError"
+func (e PartitionRoleCreateRequestValidationError) ErrorName() string {
+	return "PartitionRoleCreateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PartitionRoleListRequestValidationError) Error() string {
+func (e PartitionRoleCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1055,14 +1916,14 @@ func (e PartitionRoleListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPartitionRoleListRequest.%s: %s%s",
+		"invalid %sPartitionRoleCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PartitionRoleListRequestValidationError{}
+var _ error = PartitionRoleCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1070,39 +1931,176 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PartitionRoleListRequestValidationError{}
+} = PartitionRoleCreateRequestValidationError{}
 
-var _PartitionRoleListRequest_PartitionId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionRoleCreateRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on PartitionRoleListResponse with the rules
+// Validate checks the field values on PartitionRoleObject with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *PartitionRoleListResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PartitionRoleObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionRoleObject with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionRoleObjectMultiError, or nil if none found.
+func (m *PartitionRoleObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionRoleObject) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetRole() {
-		_, _ = idx, item
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPartitionRoleId()); l < 3 || l > 40 {
+		err := PartitionRoleObjectValidationError{
+			field:  "PartitionRoleId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PartitionRoleObject_PartitionRoleId_Pattern.MatchString(m.GetPartitionRoleId()) {
+		err := PartitionRoleObjectValidationError{
+			field:  "PartitionRoleId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+		err := PartitionRoleObjectValidationError{
+			field:  "PartitionId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PartitionRoleObject_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := PartitionRoleObjectValidationError{
+			field:  "PartitionId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := PartitionRoleObjectValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+	// no validation rules for Properties
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartitionRoleObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return PartitionRoleListResponseValidationError{
-					field:  fmt.Sprintf("Role[%v]", idx),
+				errors = append(errors, PartitionRoleObjectValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartitionRoleObjectValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetModifiedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartitionRoleObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartitionRoleObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModifiedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartitionRoleObjectValidationError{
+				field:  "ModifiedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
+
+	// no validation rules for NonInheritable
 
+	if len(errors) > 0 {
+		return PartitionRoleObjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// PartitionRoleListResponseValidationError is the validation error returned by
-// PartitionRoleListResponse.Validate if the designated constraints aren't met.
-type PartitionRoleListResponseValidationError struct {
+// PartitionRoleObjectMultiError is an error wrapping multiple validation
+// errors returned by PartitionRoleObject.ValidateAll() if the designated
+// constraints aren't met.
+type PartitionRoleObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionRoleObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionRoleObjectMultiError) AllErrors() []error { return m }
+
+// PartitionRoleObjectValidationError is the validation error returned by
+// PartitionRoleObject.Validate if the designated constraints aren't met.
+type PartitionRoleObjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1110,24 +2108,24 @@ type PartitionRoleListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PartitionRoleListResponseValidationError) Field() string { return e.field }
+func (e PartitionRoleObjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PartitionRoleListResponseValidationError) Reason() string { return e.reason }
+func (e PartitionRoleObjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PartitionRoleListResponseValidationError) Cause() error { return e.cause }
+func (e PartitionRoleObjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PartitionRoleListResponseValidationError) Key() bool { return e.key }
+func (e PartitionRoleObjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PartitionRoleListResponseValidationError) ErrorName() string {
-	return "PartitionRoleListResponseValidationError"
+func (e PartitionRoleObjectValidationError) ErrorName() string {
+	return "PartitionRoleObjectValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PartitionRoleListResponseValidationError) Error() string {
+func (e PartitionRoleObjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1139,14 +2137,14 @@ func (e PartitionRoleListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPartitionRoleListResponse.%s: %s%s",
+		"invalid %sPartitionRoleObject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PartitionRoleListResponseValidationError{}
+var _ error = PartitionRoleObjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -1154,51 +2152,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PartitionRoleListResponseValidationError{}
+} = PartitionRoleObjectValidationError{}
 
-// Validate checks the field values on PageObject with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *PageObject) Validate() error {
+var _PartitionRoleObject_PartitionRoleId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _PartitionRoleObject_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on PartitionRoleRemoveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PartitionRoleRemoveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionRoleRemoveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionRoleRemoveRequestMultiError, or nil if none found.
+func (m *PartitionRoleRemoveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionRoleRemoveRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetPageId()); l < 3 || l > 40 {
-		return PageObjectValidationError{
-			field:  "PageId",
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPartitionRoleId()); l < 3 || l > 40 {
+		err := PartitionRoleRemoveRequestValidationError{
+			field:  "PartitionRoleId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
-	}
-
-	if !_PageObject_PageId_Pattern.MatchString(m.GetPageId()) {
-		return PageObjectValidationError{
-			field:  "PageId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-		return PageObjectValidationError{
-			field:  "Name",
-			reason: "value length must be between 3 and 100 runes, inclusive",
+	if !_PartitionRoleRemoveRequest_PartitionRoleId_Pattern.MatchString(m.GetPartitionRoleId()) {
+		err := PartitionRoleRemoveRequestValidationError{
+			field:  "PartitionRoleId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
 		}
-	}
-
-	if l := utf8.RuneCountInString(m.GetHtml()); l < 4 || l > 5000 {
-		return PageObjectValidationError{
-			field:  "Html",
-			reason: "value length must be between 4 and 5000 runes, inclusive",
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for State
+	if len(errors) > 0 {
+		return PartitionRoleRemoveRequestMultiError(errors)
+	}
 
 	return nil
 }
 
-// PageObjectValidationError is the validation error returned by
-// PageObject.Validate if the designated constraints aren't met.
-type PageObjectValidationError struct {
+// PartitionRoleRemoveRequestMultiError is an error wrapping multiple
+// validation errors returned by PartitionRoleRemoveRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PartitionRoleRemoveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionRoleRemoveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionRoleRemoveRequestMultiError) AllErrors() []error { return m }
+
+// PartitionRoleRemoveRequestValidationError is the validation error returned
+// by PartitionRoleRemoveRequest.Validate if the designated constraints aren't met.
+type PartitionRoleRemoveRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1206,22 +2236,24 @@ type PageObjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e PageObjectValidationError) Field() string { return e.field }
+func (e PartitionRoleRemoveRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PageObjectValidationError) Reason() string { return e.reason }
+func (e PartitionRoleRemoveRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PageObjectValidationError) Cause() error { return e.cause }
+func (e PartitionRoleRemoveRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PageObjectValidationError) Key() bool { return e.key }
+func (e PartitionRoleRemoveRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PageObjectValidationError) ErrorName() string { return "PageObjectValidationError" }
+func (e PartitionRoleRemoveRequestValidationError) ErrorName() string {
+	return "PartitionRoleRemoveRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PageObjectValidationError) Error() string {
+func (e PartitionRoleRemoveRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1233,14 +2265,14 @@ func (e PageObjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPageObject.%s: %s%s",
+		"invalid %sPartitionRoleRemoveRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PageObjectValidationError{}
+var _ error = PartitionRoleRemoveRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1248,52 +2280,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PageObjectValidationError{}
+} = PartitionRoleRemoveRequestValidationError{}
 
-var _PageObject_PageId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionRoleRemoveRequest_PartitionRoleId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on PageCreateRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *PageCreateRequest) Validate() error {
+// Validate checks the field values on PartitionRoleListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PartitionRoleListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionRoleListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionRoleListRequestMultiError, or nil if none found.
+func (m *PartitionRoleListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionRoleListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
-		return PageCreateRequestValidationError{
+		err := PartitionRoleListRequestValidationError{
 			field:  "PartitionId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if !_PageCreateRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
-		return PageCreateRequestValidationError{
+	if !_PartitionRoleListRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := PartitionRoleListRequestValidationError{
 			field:  "PartitionId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
 		}
-	}
-
-	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-		return PageCreateRequestValidationError{
-			field:  "Name",
-			reason: "value length must be between 3 and 100 runes, inclusive",
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetHtml()); l < 4 || l > 5000 {
-		return PageCreateRequestValidationError{
-			field:  "Html",
-			reason: "value length must be between 4 and 5000 runes, inclusive",
-		}
+	if len(errors) > 0 {
+		return PartitionRoleListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PageCreateRequestValidationError is the validation error returned by
-// PageCreateRequest.Validate if the designated constraints aren't met.
-type PageCreateRequestValidationError struct {
+// PartitionRoleListRequestMultiError is an error wrapping multiple validation
+// errors returned by PartitionRoleListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PartitionRoleListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionRoleListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionRoleListRequestMultiError) AllErrors() []error { return m }
+
+// PartitionRoleListRequestValidationError is the validation error returned by
+// PartitionRoleListRequest.Validate if the designated constraints aren't met.
+type PartitionRoleListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1301,24 +2362,24 @@ type PageCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PageCreateRequestValidationError) Field() string { return e.field }
+func (e PartitionRoleListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PageCreateRequestValidationError) Reason() string { return e.reason }
+func (e PartitionRoleListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PageCreateRequestValidationError) Cause() error { return e.cause }
+func (e PartitionRoleListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PageCreateRequestValidationError) Key() bool { return e.key }
+func (e PartitionRoleListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PageCreateRequestValidationError) ErrorName() string {
-	return "PageCreateRequestValidationError"
+func (e PartitionRoleListRequestValidationError) ErrorName() string {
+	return "PartitionRoleListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PageCreateRequestValidationError) Error() string {
+func (e PartitionRoleListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1330,14 +2391,14 @@ func (e PageCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPageCreateRequest.%s: %s%s",
+		"invalid %sPartitionRoleListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PageCreateRequestValidationError{}
+var _ error = PartitionRoleListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1345,71 +2406,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PageCreateRequestValidationError{}
+} = PartitionRoleListRequestValidationError{}
 
-var _PageCreateRequest_PartitionId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PartitionRoleListRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on PageGetRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *PageGetRequest) Validate() error {
+// Validate checks the field values on PartitionRoleListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PartitionRoleListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionRoleListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionRoleListResponseMultiError, or nil if none found.
+func (m *PartitionRoleListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionRoleListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetPageId() != "" {
+	var errors []error
 
-		if l := utf8.RuneCountInString(m.GetPageId()); l < 3 || l > 40 {
-			return PageGetRequestValidationError{
-				field:  "PageId",
-				reason: "value length must be between 3 and 40 runes, inclusive",
-			}
-		}
+	for idx, item := range m.GetRole() {
+		_, _ = idx, item
 
-		if !_PageGetRequest_PageId_Pattern.MatchString(m.GetPageId()) {
-			return PageGetRequestValidationError{
-				field:  "PageId",
-				reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PartitionRoleListResponseValidationError{
+						field:  fmt.Sprintf("Role[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PartitionRoleListResponseValidationError{
+						field:  fmt.Sprintf("Role[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PartitionRoleListResponseValidationError{
+					field:  fmt.Sprintf("Role[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
 
 	}
 
-	if m.GetPartitionId() != "" {
-
-		if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
-			return PageGetRequestValidationError{
-				field:  "PartitionId",
-				reason: "value length must be between 3 and 40 runes, inclusive",
-			}
-		}
-
-		if !_PageGetRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
-			return PageGetRequestValidationError{
-				field:  "PartitionId",
-				reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-			}
-		}
-
+	if len(errors) > 0 {
+		return PartitionRoleListResponseMultiError(errors)
 	}
 
-	if m.GetName() != "" {
+	return nil
+}
 
-		if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-			return PageGetRequestValidationError{
-				field:  "Name",
-				reason: "value length must be between 3 and 100 runes, inclusive",
-			}
-		}
+// PartitionRoleListResponseMultiError is an error wrapping multiple validation
+// errors returned by PartitionRoleListResponse.ValidateAll() if the
+// designated constraints aren't met.
+type PartitionRoleListResponseMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionRoleListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
-
-	return nil
+	return strings.Join(msgs, "; ")
 }
 
-// PageGetRequestValidationError is the validation error returned by
-// PageGetRequest.Validate if the designated constraints aren't met.
-type PageGetRequestValidationError struct {
+// AllErrors returns a list of validation violation errors.
+func (m PartitionRoleListResponseMultiError) AllErrors() []error { return m }
+
+// PartitionRoleListResponseValidationError is the validation error returned by
+// PartitionRoleListResponse.Validate if the designated constraints aren't met.
+type PartitionRoleListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1417,22 +2500,24 @@ type PageGetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PageGetRequestValidationError) Field() string { return e.field }
+func (e PartitionRoleListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PageGetRequestValidationError) Reason() string { return e.reason }
+func (e PartitionRoleListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PageGetRequestValidationError) Cause() error { return e.cause }
+func (e PartitionRoleListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PageGetRequestValidationError) Key() bool { return e.key }
+func (e PartitionRoleListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PageGetRequestValidationError) ErrorName() string { return "PageGetRequestValidationError" }
+func (e PartitionRoleListResponseValidationError) ErrorName() string {
+	return "PartitionRoleListResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PageGetRequestValidationError) Error() string {
+func (e PartitionRoleListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1444,14 +2529,14 @@ func (e PageGetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPageGetRequest.%s: %s%s",
+		"invalid %sPartitionRoleListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PageGetRequestValidationError{}
+var _ error = PartitionRoleListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1459,40 +2544,164 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PageGetRequestValidationError{}
+} = PartitionRoleListResponseValidationError{}
 
-var _PageGetRequest_PageId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+// Validate checks the field values on PageObject with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PageObject) Validate() error {
+	return m.validate(false)
+}
 
-var _PageGetRequest_PartitionId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+// ValidateAll checks the field values on PageObject with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PageObjectMultiError, or
+// nil if none found.
+func (m *PageObject) ValidateAll() error {
+	return m.validate(true)
+}
 
-// Validate checks the field values on PageRemoveRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *PageRemoveRequest) Validate() error {
+func (m *PageObject) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if l := utf8.RuneCountInString(m.GetPageId()); l < 3 || l > 40 {
-		return PageRemoveRequestValidationError{
+		err := PageObjectValidationError{
 			field:  "PageId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if !_PageRemoveRequest_PageId_Pattern.MatchString(m.GetPageId()) {
-		return PageRemoveRequestValidationError{
+	if !_PageObject_PageId_Pattern.MatchString(m.GetPageId()) {
+		err := PageObjectValidationError{
 			field:  "PageId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := PageObjectValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetHtml()); l < 4 || l > 5000 {
+		err := PageObjectValidationError{
+			field:  "Html",
+			reason: "value length must be between 4 and 5000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PageObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PageObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PageObjectValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetModifiedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PageObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PageObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModifiedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PageObjectValidationError{
+				field:  "ModifiedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
 	}
 
+	// no validation rules for Version
+
+	// no validation rules for Template
+
+	if len(errors) > 0 {
+		return PageObjectMultiError(errors)
+	}
+
 	return nil
 }
 
-// PageRemoveRequestValidationError is the validation error returned by
-// PageRemoveRequest.Validate if the designated constraints aren't met.
-type PageRemoveRequestValidationError struct {
+// PageObjectMultiError is an error wrapping multiple validation errors
+// returned by PageObject.ValidateAll() if the designated constraints aren't met.
+type PageObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageObjectMultiError) AllErrors() []error { return m }
+
+// PageObjectValidationError is the validation error returned by
+// PageObject.Validate if the designated constraints aren't met.
+type PageObjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1500,24 +2709,22 @@ type PageRemoveRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PageRemoveRequestValidationError) Field() string { return e.field }
+func (e PageObjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PageRemoveRequestValidationError) Reason() string { return e.reason }
+func (e PageObjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PageRemoveRequestValidationError) Cause() error { return e.cause }
+func (e PageObjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PageRemoveRequestValidationError) Key() bool { return e.key }
+func (e PageObjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PageRemoveRequestValidationError) ErrorName() string {
-	return "PageRemoveRequestValidationError"
-}
+func (e PageObjectValidationError) ErrorName() string { return "PageObjectValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PageRemoveRequestValidationError) Error() string {
+func (e PageObjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1529,14 +2736,14 @@ func (e PageRemoveRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPageRemoveRequest.%s: %s%s",
+		"invalid %sPageObject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PageRemoveRequestValidationError{}
+var _ error = PageObjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -1544,64 +2751,105 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PageRemoveRequestValidationError{}
+} = PageObjectValidationError{}
 
-var _PageRemoveRequest_PageId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PageObject_PageId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on AccessObject with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *AccessObject) Validate() error {
+// Validate checks the field values on PageCreateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PageCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PageCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PageCreateRequestMultiError, or nil if none found.
+func (m *PageCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
-		return AccessObjectValidationError{
-			field:  "AccessId",
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+		err := PageCreateRequestValidationError{
+			field:  "PartitionId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
-	}
-
-	if !_AccessObject_AccessId_Pattern.MatchString(m.GetAccessId()) {
-		return AccessObjectValidationError{
-			field:  "AccessId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetProfileId()); l < 3 || l > 40 {
-		return AccessObjectValidationError{
-			field:  "ProfileId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
+	if !_PageCreateRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := PageCreateRequestValidationError{
+			field:  "PartitionId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if !_AccessObject_ProfileId_Pattern.MatchString(m.GetProfileId()) {
-		return AccessObjectValidationError{
-			field:  "ProfileId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := PageCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if v, ok := interface{}(m.GetPartition()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AccessObjectValidationError{
-				field:  "Partition",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	if l := utf8.RuneCountInString(m.GetHtml()); l < 4 || l > 5000 {
+		err := PageCreateRequestValidationError{
+			field:  "Html",
+			reason: "value length must be between 4 and 5000 runes, inclusive",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for State
+	// no validation rules for Template
+
+	if len(errors) > 0 {
+		return PageCreateRequestMultiError(errors)
+	}
 
 	return nil
 }
 
-// AccessObjectValidationError is the validation error returned by
-// AccessObject.Validate if the designated constraints aren't met.
-type AccessObjectValidationError struct {
+// PageCreateRequestMultiError is an error wrapping multiple validation errors
+// returned by PageCreateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PageCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageCreateRequestMultiError) AllErrors() []error { return m }
+
+// PageCreateRequestValidationError is the validation error returned by
+// PageCreateRequest.Validate if the designated constraints aren't met.
+type PageCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1609,22 +2857,24 @@ type AccessObjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccessObjectValidationError) Field() string { return e.field }
+func (e PageCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccessObjectValidationError) Reason() string { return e.reason }
+func (e PageCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccessObjectValidationError) Cause() error { return e.cause }
+func (e PageCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccessObjectValidationError) Key() bool { return e.key }
+func (e PageCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccessObjectValidationError) ErrorName() string { return "AccessObjectValidationError" }
+func (e PageCreateRequestValidationError) ErrorName() string {
+	return "PageCreateRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AccessObjectValidationError) Error() string {
+func (e PageCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1636,14 +2886,14 @@ func (e AccessObjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccessObject.%s: %s%s",
+		"invalid %sPageCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccessObjectValidationError{}
+var _ error = PageCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1651,54 +2901,126 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccessObjectValidationError{}
+} = PageCreateRequestValidationError{}
 
-var _AccessObject_AccessId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PageCreateRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-var _AccessObject_ProfileId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+// Validate checks the field values on PageGetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PageGetRequest) Validate() error {
+	return m.validate(false)
+}
 
-// Validate checks the field values on AccessCreateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *AccessCreateRequest) Validate() error {
+// ValidateAll checks the field values on PageGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PageGetRequestMultiError,
+// or nil if none found.
+func (m *PageGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageGetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
-		return AccessCreateRequestValidationError{
-			field:  "PartitionId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
-		}
-	}
+	var errors []error
 
-	if !_AccessCreateRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
-		return AccessCreateRequestValidationError{
-			field:  "PartitionId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-		}
-	}
+	if m.GetPageId() != "" {
 
-	if l := utf8.RuneCountInString(m.GetProfileId()); l < 3 || l > 40 {
-		return AccessCreateRequestValidationError{
-			field:  "ProfileId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
+		if l := utf8.RuneCountInString(m.GetPageId()); l < 3 || l > 40 {
+			err := PageGetRequestValidationError{
+				field:  "PageId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
 
-	if !_AccessCreateRequest_ProfileId_Pattern.MatchString(m.GetProfileId()) {
-		return AccessCreateRequestValidationError{
-			field:  "ProfileId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+		if !_PageGetRequest_PageId_Pattern.MatchString(m.GetPageId()) {
+			err := PageGetRequestValidationError{
+				field:  "PageId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
-	return nil
-}
+	if m.GetPartitionId() != "" {
 
-// AccessCreateRequestValidationError is the validation error returned by
-// AccessCreateRequest.Validate if the designated constraints aren't met.
-type AccessCreateRequestValidationError struct {
+		if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+			err := PageGetRequestValidationError{
+				field:  "PartitionId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PageGetRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+			err := PageGetRequestValidationError{
+				field:  "PartitionId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetName() != "" {
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+			err := PageGetRequestValidationError{
+				field:  "Name",
+				reason: "value length must be between 3 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PageGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PageGetRequestMultiError is an error wrapping multiple validation errors
+// returned by PageGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PageGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageGetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageGetRequestMultiError) AllErrors() []error { return m }
+
+// PageGetRequestValidationError is the validation error returned by
+// PageGetRequest.Validate if the designated constraints aren't met.
+type PageGetRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1706,24 +3028,22 @@ type AccessCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccessCreateRequestValidationError) Field() string { return e.field }
+func (e PageGetRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccessCreateRequestValidationError) Reason() string { return e.reason }
+func (e PageGetRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccessCreateRequestValidationError) Cause() error { return e.cause }
+func (e PageGetRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccessCreateRequestValidationError) Key() bool { return e.key }
+func (e PageGetRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccessCreateRequestValidationError) ErrorName() string {
-	return "AccessCreateRequestValidationError"
-}
+func (e PageGetRequestValidationError) ErrorName() string { return "PageGetRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AccessCreateRequestValidationError) Error() string {
+func (e PageGetRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1735,14 +3055,14 @@ func (e AccessCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccessCreateRequest.%s: %s%s",
+		"invalid %sPageGetRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccessCreateRequestValidationError{}
+var _ error = PageGetRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1750,80 +3070,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccessCreateRequestValidationError{}
+} = PageGetRequestValidationError{}
 
-var _AccessCreateRequest_PartitionId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PageGetRequest_PageId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-var _AccessCreateRequest_ProfileId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PageGetRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on AccessGetRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *AccessGetRequest) Validate() error {
+// Validate checks the field values on PageRemoveRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PageRemoveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PageRemoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PageRemoveRequestMultiError, or nil if none found.
+func (m *PageRemoveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageRemoveRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetAccessId() != "" {
+	var errors []error
 
-		if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
-			return AccessGetRequestValidationError{
-				field:  "AccessId",
-				reason: "value length must be between 3 and 40 runes, inclusive",
-			}
+	if l := utf8.RuneCountInString(m.GetPageId()); l < 3 || l > 40 {
+		err := PageRemoveRequestValidationError{
+			field:  "PageId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
-
-		if !_AccessGetRequest_AccessId_Pattern.MatchString(m.GetAccessId()) {
-			return AccessGetRequestValidationError{
-				field:  "AccessId",
-				reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-			}
+		if !all {
+			return err
 		}
-
+		errors = append(errors, err)
 	}
 
-	if m.GetPartitionId() != "" {
-
-		if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
-			return AccessGetRequestValidationError{
-				field:  "PartitionId",
-				reason: "value length must be between 3 and 40 runes, inclusive",
-			}
+	if !_PageRemoveRequest_PageId_Pattern.MatchString(m.GetPageId()) {
+		err := PageRemoveRequestValidationError{
+			field:  "PageId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
 		}
-
-		if !_AccessGetRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
-			return AccessGetRequestValidationError{
-				field:  "PartitionId",
-				reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-			}
+		if !all {
+			return err
 		}
-
+		errors = append(errors, err)
 	}
 
-	if m.GetProfileId() != "" {
+	if len(errors) > 0 {
+		return PageRemoveRequestMultiError(errors)
+	}
 
-		if l := utf8.RuneCountInString(m.GetProfileId()); l < 3 || l > 40 {
-			return AccessGetRequestValidationError{
-				field:  "ProfileId",
-				reason: "value length must be between 3 and 40 runes, inclusive",
-			}
-		}
+	return nil
+}
 
-		if !_AccessGetRequest_ProfileId_Pattern.MatchString(m.GetProfileId()) {
-			return AccessGetRequestValidationError{
-				field:  "ProfileId",
-				reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-			}
-		}
+// PageRemoveRequestMultiError is an error wrapping multiple validation errors
+// returned by PageRemoveRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PageRemoveRequestMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageRemoveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
-
-	return nil
+	return strings.Join(msgs, "; ")
 }
 
-// AccessGetRequestValidationError is the validation error returned by
-// AccessGetRequest.Validate if the designated constraints aren't met.
-type AccessGetRequestValidationError struct {
+// AllErrors returns a list of validation violation errors.
+func (m PageRemoveRequestMultiError) AllErrors() []error { return m }
+
+// PageRemoveRequestValidationError is the validation error returned by
+// PageRemoveRequest.Validate if the designated constraints aren't met.
+type PageRemoveRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1831,22 +3154,24 @@ type AccessGetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccessGetRequestValidationError) Field() string { return e.field }
+func (e PageRemoveRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccessGetRequestValidationError) Reason() string { return e.reason }
+func (e PageRemoveRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccessGetRequestValidationError) Cause() error { return e.cause }
+func (e PageRemoveRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccessGetRequestValidationError) Key() bool { return e.key }
+func (e PageRemoveRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccessGetRequestValidationError) ErrorName() string { return "AccessGetRequestValidationError" }
+func (e PageRemoveRequestValidationError) ErrorName() string {
+	return "PageRemoveRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AccessGetRequestValidationError) Error() string {
+func (e PageRemoveRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1858,14 +3183,14 @@ func (e AccessGetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccessGetRequest.%s: %s%s",
+		"invalid %sPageRemoveRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccessGetRequestValidationError{}
+var _ error = PageRemoveRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1873,42 +3198,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccessGetRequestValidationError{}
-
-var _AccessGetRequest_AccessId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+} = PageRemoveRequestValidationError{}
 
-var _AccessGetRequest_PartitionId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PageRemoveRequest_PageId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-var _AccessGetRequest_ProfileId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+// Validate checks the field values on PageListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PageListRequest) Validate() error {
+	return m.validate(false)
+}
 
-// Validate checks the field values on AccessRemoveRequest with the rules
+// ValidateAll checks the field values on PageListRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *AccessRemoveRequest) Validate() error {
+// violated, the result is a list of violation errors wrapped in
+// PageListRequestMultiError, or nil if none found.
+func (m *PageListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
-		return AccessRemoveRequestValidationError{
-			field:  "AccessId",
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+		err := PageListRequestValidationError{
+			field:  "PartitionId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if !_AccessRemoveRequest_AccessId_Pattern.MatchString(m.GetAccessId()) {
-		return AccessRemoveRequestValidationError{
-			field:  "AccessId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+	if !_PageListRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := PageListRequestValidationError{
+			field:  "PartitionId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PageListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AccessRemoveRequestValidationError is the validation error returned by
-// AccessRemoveRequest.Validate if the designated constraints aren't met.
-type AccessRemoveRequestValidationError struct {
+// PageListRequestMultiError is an error wrapping multiple validation errors
+// returned by PageListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PageListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageListRequestMultiError) AllErrors() []error { return m }
+
+// PageListRequestValidationError is the validation error returned by
+// PageListRequest.Validate if the designated constraints aren't met.
+type PageListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1916,24 +3280,22 @@ type AccessRemoveRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccessRemoveRequestValidationError) Field() string { return e.field }
+func (e PageListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccessRemoveRequestValidationError) Reason() string { return e.reason }
+func (e PageListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccessRemoveRequestValidationError) Cause() error { return e.cause }
+func (e PageListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccessRemoveRequestValidationError) Key() bool { return e.key }
+func (e PageListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccessRemoveRequestValidationError) ErrorName() string {
-	return "AccessRemoveRequestValidationError"
-}
+func (e PageListRequestValidationError) ErrorName() string { return "PageListRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AccessRemoveRequestValidationError) Error() string {
+func (e PageListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1945,14 +3307,14 @@ func (e AccessRemoveRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccessRemoveRequest.%s: %s%s",
+		"invalid %sPageListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccessRemoveRequestValidationError{}
+var _ error = PageListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1960,52 +3322,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccessRemoveRequestValidationError{}
+} = PageListRequestValidationError{}
 
-var _AccessRemoveRequest_AccessId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PageListRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on AccessRoleCreateRequest with the rules
+// Validate checks the field values on PageListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PageListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PageListResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *AccessRoleCreateRequest) Validate() error {
+// violated, the result is a list of violation errors wrapped in
+// PageListResponseMultiError, or nil if none found.
+func (m *PageListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
-		return AccessRoleCreateRequestValidationError{
-			field:  "AccessId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
-		}
-	}
+	var errors []error
 
-	if !_AccessRoleCreateRequest_AccessId_Pattern.MatchString(m.GetAccessId()) {
-		return AccessRoleCreateRequestValidationError{
-			field:  "AccessId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-		}
-	}
+	for idx, item := range m.GetPage() {
+		_, _ = idx, item
 
-	if l := utf8.RuneCountInString(m.GetPartitionRoleId()); l < 3 || l > 40 {
-		return AccessRoleCreateRequestValidationError{
-			field:  "PartitionRoleId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PageListResponseValidationError{
+						field:  fmt.Sprintf("Page[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PageListResponseValidationError{
+						field:  fmt.Sprintf("Page[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PageListResponseValidationError{
+					field:  fmt.Sprintf("Page[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
+
 	}
 
-	if !_AccessRoleCreateRequest_PartitionRoleId_Pattern.MatchString(m.GetPartitionRoleId()) {
-		return AccessRoleCreateRequestValidationError{
-			field:  "PartitionRoleId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-		}
+	if len(errors) > 0 {
+		return PageListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AccessRoleCreateRequestValidationError is the validation error returned by
-// AccessRoleCreateRequest.Validate if the designated constraints aren't met.
-type AccessRoleCreateRequestValidationError struct {
+// PageListResponseMultiError is an error wrapping multiple validation errors
+// returned by PageListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PageListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageListResponseMultiError) AllErrors() []error { return m }
+
+// PageListResponseValidationError is the validation error returned by
+// PageListResponse.Validate if the designated constraints aren't met.
+type PageListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2013,24 +3416,22 @@ type AccessRoleCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccessRoleCreateRequestValidationError) Field() string { return e.field }
+func (e PageListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccessRoleCreateRequestValidationError) Reason() string { return e.reason }
+func (e PageListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccessRoleCreateRequestValidationError) Cause() error { return e.cause }
+func (e PageListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccessRoleCreateRequestValidationError) Key() bool { return e.key }
+func (e PageListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccessRoleCreateRequestValidationError) ErrorName() string {
-	return "AccessRoleCreateRequestValidationError"
-}
+func (e PageListResponseValidationError) ErrorName() string { return "PageListResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AccessRoleCreateRequestValidationError) Error() string {
+func (e PageListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2042,14 +3443,14 @@ func (e AccessRoleCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccessRoleCreateRequest.%s: %s%s",
+		"invalid %sPageListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccessRoleCreateRequestValidationError{}
+var _ error = PageListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2057,64 +3458,103 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccessRoleCreateRequestValidationError{}
+} = PageListResponseValidationError{}
 
-var _AccessRoleCreateRequest_AccessId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+// Validate checks the field values on PageUpdateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PageUpdateRequest) Validate() error {
+	return m.validate(false)
+}
 
-var _AccessRoleCreateRequest_PartitionRoleId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+// ValidateAll checks the field values on PageUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PageUpdateRequestMultiError, or nil if none found.
+func (m *PageUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
-// Validate checks the field values on AccessRoleObject with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *AccessRoleObject) Validate() error {
+func (m *PageUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetAccessRoleId()); l < 3 || l > 40 {
-		return AccessRoleObjectValidationError{
-			field:  "AccessRoleId",
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPageId()); l < 3 || l > 40 {
+		err := PageUpdateRequestValidationError{
+			field:  "PageId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if !_AccessRoleObject_AccessRoleId_Pattern.MatchString(m.GetAccessRoleId()) {
-		return AccessRoleObjectValidationError{
-			field:  "AccessRoleId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+	if !_PageUpdateRequest_PageId_Pattern.MatchString(m.GetPageId()) {
+		err := PageUpdateRequestValidationError{
+			field:  "PageId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
-		return AccessRoleObjectValidationError{
-			field:  "AccessId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := PageUpdateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if !_AccessRoleObject_AccessId_Pattern.MatchString(m.GetAccessId()) {
-		return AccessRoleObjectValidationError{
-			field:  "AccessId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+	if l := utf8.RuneCountInString(m.GetHtml()); l < 4 || l > 5000 {
+		err := PageUpdateRequestValidationError{
+			field:  "Html",
+			reason: "value length must be between 4 and 5000 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AccessRoleObjectValidationError{
-				field:  "Role",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return PageUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AccessRoleObjectValidationError is the validation error returned by
-// AccessRoleObject.Validate if the designated constraints aren't met.
-type AccessRoleObjectValidationError struct {
+// PageUpdateRequestMultiError is an error wrapping multiple validation errors
+// returned by PageUpdateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PageUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageUpdateRequestMultiError) AllErrors() []error { return m }
+
+// PageUpdateRequestValidationError is the validation error returned by
+// PageUpdateRequest.Validate if the designated constraints aren't met.
+type PageUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2122,22 +3562,24 @@ type AccessRoleObjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccessRoleObjectValidationError) Field() string { return e.field }
+func (e PageUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccessRoleObjectValidationError) Reason() string { return e.reason }
+func (e PageUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccessRoleObjectValidationError) Cause() error { return e.cause }
+func (e PageUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccessRoleObjectValidationError) Key() bool { return e.key }
+func (e PageUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccessRoleObjectValidationError) ErrorName() string { return "AccessRoleObjectValidationError" }
+func (e PageUpdateRequestValidationError) ErrorName() string {
+	return "PageUpdateRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AccessRoleObjectValidationError) Error() string {
+func (e PageUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2149,14 +3591,14 @@ func (e AccessRoleObjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccessRoleObject.%s: %s%s",
+		"invalid %sPageUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccessRoleObjectValidationError{}
+var _ error = PageUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2164,40 +3606,91 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccessRoleObjectValidationError{}
+} = PageUpdateRequestValidationError{}
 
-var _AccessRoleObject_AccessRoleId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PageUpdateRequest_PageId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-var _AccessRoleObject_AccessId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+// Validate checks the field values on PageVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PageVersion) Validate() error {
+	return m.validate(false)
+}
 
-// Validate checks the field values on AccessRoleRemoveRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *AccessRoleRemoveRequest) Validate() error {
+// ValidateAll checks the field values on PageVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PageVersionMultiError, or
+// nil if none found.
+func (m *PageVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetAccessRoleId()); l < 3 || l > 40 {
-		return AccessRoleRemoveRequestValidationError{
-			field:  "AccessRoleId",
-			reason: "value length must be between 3 and 40 runes, inclusive",
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Html
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PageVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PageVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PageVersionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
 	}
 
-	if !_AccessRoleRemoveRequest_AccessRoleId_Pattern.MatchString(m.GetAccessRoleId()) {
-		return AccessRoleRemoveRequestValidationError{
-			field:  "AccessRoleId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
-		}
+	if len(errors) > 0 {
+		return PageVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// AccessRoleRemoveRequestValidationError is the validation error returned by
-// AccessRoleRemoveRequest.Validate if the designated constraints aren't met.
-type AccessRoleRemoveRequestValidationError struct {
+// PageVersionMultiError is an error wrapping multiple validation errors
+// returned by PageVersion.ValidateAll() if the designated constraints aren't met.
+type PageVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageVersionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageVersionMultiError) AllErrors() []error { return m }
+
+// PageVersionValidationError is the validation error returned by
+// PageVersion.Validate if the designated constraints aren't met.
+type PageVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2205,24 +3698,22 @@ type AccessRoleRemoveRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccessRoleRemoveRequestValidationError) Field() string { return e.field }
+func (e PageVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccessRoleRemoveRequestValidationError) Reason() string { return e.reason }
+func (e PageVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccessRoleRemoveRequestValidationError) Cause() error { return e.cause }
+func (e PageVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccessRoleRemoveRequestValidationError) Key() bool { return e.key }
+func (e PageVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccessRoleRemoveRequestValidationError) ErrorName() string {
-	return "AccessRoleRemoveRequestValidationError"
-}
+func (e PageVersionValidationError) ErrorName() string { return "PageVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AccessRoleRemoveRequestValidationError) Error() string {
+func (e PageVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2234,14 +3725,14 @@ func (e AccessRoleRemoveRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccessRoleRemoveRequest.%s: %s%s",
+		"invalid %sPageVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccessRoleRemoveRequestValidationError{}
+var _ error = PageVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2249,38 +3740,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccessRoleRemoveRequestValidationError{}
+} = PageVersionValidationError{}
 
-var _AccessRoleRemoveRequest_AccessRoleId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+// Validate checks the field values on PageVersionListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PageVersionListRequest) Validate() error {
+	return m.validate(false)
+}
 
-// Validate checks the field values on AccessRoleListRequest with the rules
+// ValidateAll checks the field values on PageVersionListRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *AccessRoleListRequest) Validate() error {
+// violated, the result is a list of violation errors wrapped in
+// PageVersionListRequestMultiError, or nil if none found.
+func (m *PageVersionListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageVersionListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
-		return AccessRoleListRequestValidationError{
-			field:  "AccessId",
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPageId()); l < 3 || l > 40 {
+		err := PageVersionListRequestValidationError{
+			field:  "PageId",
 			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if !_AccessRoleListRequest_AccessId_Pattern.MatchString(m.GetAccessId()) {
-		return AccessRoleListRequestValidationError{
-			field:  "AccessId",
-			reason: "value does not match regex pattern \"[0-9a-z_-]{3,20}\"",
+	if !_PageVersionListRequest_PageId_Pattern.MatchString(m.GetPageId()) {
+		err := PageVersionListRequestValidationError{
+			field:  "PageId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PageVersionListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AccessRoleListRequestValidationError is the validation error returned by
-// AccessRoleListRequest.Validate if the designated constraints aren't met.
-type AccessRoleListRequestValidationError struct {
+// PageVersionListRequestMultiError is an error wrapping multiple validation
+// errors returned by PageVersionListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PageVersionListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageVersionListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageVersionListRequestMultiError) AllErrors() []error { return m }
+
+// PageVersionListRequestValidationError is the validation error returned by
+// PageVersionListRequest.Validate if the designated constraints aren't met.
+type PageVersionListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2288,24 +3820,24 @@ type AccessRoleListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccessRoleListRequestValidationError) Field() string { return e.field }
+func (e PageVersionListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccessRoleListRequestValidationError) Reason() string { return e.reason }
+func (e PageVersionListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccessRoleListRequestValidationError) Cause() error { return e.cause }
+func (e PageVersionListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccessRoleListRequestValidationError) Key() bool { return e.key }
+func (e PageVersionListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccessRoleListRequestValidationError) ErrorName() string {
-	return "AccessRoleListRequestValidationError"
+func (e PageVersionListRequestValidationError) ErrorName() string {
+	return "PageVersionListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AccessRoleListRequestValidationError) Error() string {
+func (e PageVersionListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2317,14 +3849,14 @@ func (e AccessRoleListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccessRoleListRequest.%s: %s%s",
+		"invalid %sPageVersionListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccessRoleListRequestValidationError{}
+var _ error = PageVersionListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2332,25 +3864,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccessRoleListRequestValidationError{}
+} = PageVersionListRequestValidationError{}
 
-var _AccessRoleListRequest_AccessId_Pattern = regexp.MustCompile("[0-9a-z_-]{3,20}")
+var _PageVersionListRequest_PageId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
 
-// Validate checks the field values on AccessRoleListResponse with the rules
+// Validate checks the field values on PageVersionListResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *AccessRoleListResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PageVersionListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PageVersionListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PageVersionListResponseMultiError, or nil if none found.
+func (m *PageVersionListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageVersionListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetRole() {
+	var errors []error
+
+	for idx, item := range m.GetVersion() {
 		_, _ = idx, item
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PageVersionListResponseValidationError{
+						field:  fmt.Sprintf("Version[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PageVersionListResponseValidationError{
+						field:  fmt.Sprintf("Version[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AccessRoleListResponseValidationError{
-					field:  fmt.Sprintf("Role[%v]", idx),
+				return PageVersionListResponseValidationError{
+					field:  fmt.Sprintf("Version[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2359,12 +3924,33 @@ func (m *AccessRoleListResponse) Validate() error {
 
 	}
 
+	if len(errors) > 0 {
+		return PageVersionListResponseMultiError(errors)
+	}
+
 	return nil
 }
 
-// AccessRoleListResponseValidationError is the validation error returned by
-// AccessRoleListResponse.Validate if the designated constraints aren't met.
-type AccessRoleListResponseValidationError struct {
+// PageVersionListResponseMultiError is an error wrapping multiple validation
+// errors returned by PageVersionListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PageVersionListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageVersionListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageVersionListResponseMultiError) AllErrors() []error { return m }
+
+// PageVersionListResponseValidationError is the validation error returned by
+// PageVersionListResponse.Validate if the designated constraints aren't met.
+type PageVersionListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2372,24 +3958,24 @@ type AccessRoleListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccessRoleListResponseValidationError) Field() string { return e.field }
+func (e PageVersionListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccessRoleListResponseValidationError) Reason() string { return e.reason }
+func (e PageVersionListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccessRoleListResponseValidationError) Cause() error { return e.cause }
+func (e PageVersionListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccessRoleListResponseValidationError) Key() bool { return e.key }
+func (e PageVersionListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccessRoleListResponseValidationError) ErrorName() string {
-	return "AccessRoleListResponseValidationError"
+func (e PageVersionListResponseValidationError) ErrorName() string {
+	return "PageVersionListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AccessRoleListResponseValidationError) Error() string {
+func (e PageVersionListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2401,14 +3987,14 @@ func (e AccessRoleListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccessRoleListResponse.%s: %s%s",
+		"invalid %sPageVersionListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccessRoleListResponseValidationError{}
+var _ error = PageVersionListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2416,55 +4002,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccessRoleListResponseValidationError{}
+} = PageVersionListResponseValidationError{}
 
-// Validate checks the field values on SearchRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *SearchRequest) Validate() error {
+// Validate checks the field values on PageRenderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PageRenderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PageRenderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PageRenderRequestMultiError, or nil if none found.
+func (m *PageRenderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageRenderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetQuery() != "" {
+	var errors []error
 
-		if utf8.RuneCountInString(m.GetQuery()) > 100 {
-			return SearchRequestValidationError{
-				field:  "Query",
-				reason: "value length must be at most 100 runes",
-			}
+	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+		err := PageRenderRequestValidationError{
+			field:  "PartitionId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.GetCount() != 0 {
-
-		if val := m.GetCount(); val < 5 || val >= 500 {
-			return SearchRequestValidationError{
-				field:  "Count",
-				reason: "value must be inside range [5, 500)",
-			}
+	if !_PageRenderRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := PageRenderRequestValidationError{
+			field:  "PartitionId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.GetPage() != 0 {
-
-		if m.GetPage() < 1 {
-			return SearchRequestValidationError{
-				field:  "Page",
-				reason: "value must be greater than or equal to 1",
-			}
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := PageRenderRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if len(errors) > 0 {
+		return PageRenderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchRequestValidationError is the validation error returned by
-// SearchRequest.Validate if the designated constraints aren't met.
-type SearchRequestValidationError struct {
+// PageRenderRequestMultiError is an error wrapping multiple validation errors
+// returned by PageRenderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PageRenderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageRenderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageRenderRequestMultiError) AllErrors() []error { return m }
+
+// PageRenderRequestValidationError is the validation error returned by
+// PageRenderRequest.Validate if the designated constraints aren't met.
+type PageRenderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2472,22 +4093,24 @@ type SearchRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchRequestValidationError) Field() string { return e.field }
+func (e PageRenderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchRequestValidationError) Reason() string { return e.reason }
+func (e PageRenderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchRequestValidationError) Cause() error { return e.cause }
+func (e PageRenderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchRequestValidationError) Key() bool { return e.key }
+func (e PageRenderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchRequestValidationError) ErrorName() string { return "SearchRequestValidationError" }
+func (e PageRenderRequestValidationError) ErrorName() string {
+	return "PageRenderRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SearchRequestValidationError) Error() string {
+func (e PageRenderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2499,14 +4122,14 @@ func (e SearchRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchRequest.%s: %s%s",
+		"invalid %sPageRenderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchRequestValidationError{}
+var _ error = PageRenderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2514,4 +4137,3658 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchRequestValidationError{}
+} = PageRenderRequestValidationError{}
+
+var _PageRenderRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on PageRenderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PageRenderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PageRenderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PageRenderResponseMultiError, or nil if none found.
+func (m *PageRenderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageRenderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Html
+
+	if m.GetPageId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetPageId()); l < 3 || l > 40 {
+			err := PageRenderResponseValidationError{
+				field:  "PageId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PageRenderResponse_PageId_Pattern.MatchString(m.GetPageId()) {
+			err := PageRenderResponseValidationError{
+				field:  "PageId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPagePartitionId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetPagePartitionId()); l < 3 || l > 40 {
+			err := PageRenderResponseValidationError{
+				field:  "PagePartitionId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PageRenderResponse_PagePartitionId_Pattern.MatchString(m.GetPagePartitionId()) {
+			err := PageRenderResponseValidationError{
+				field:  "PagePartitionId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return PageRenderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PageRenderResponseMultiError is an error wrapping multiple validation errors
+// returned by PageRenderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PageRenderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageRenderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageRenderResponseMultiError) AllErrors() []error { return m }
+
+// PageRenderResponseValidationError is the validation error returned by
+// PageRenderResponse.Validate if the designated constraints aren't met.
+type PageRenderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PageRenderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PageRenderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PageRenderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PageRenderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PageRenderResponseValidationError) ErrorName() string {
+	return "PageRenderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PageRenderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPageRenderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PageRenderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PageRenderResponseValidationError{}
+
+var _PageRenderResponse_PageId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _PageRenderResponse_PagePartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on PageRollbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PageRollbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PageRollbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PageRollbackRequestMultiError, or nil if none found.
+func (m *PageRollbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageRollbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPageId()); l < 3 || l > 40 {
+		err := PageRollbackRequestValidationError{
+			field:  "PageId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PageRollbackRequest_PageId_Pattern.MatchString(m.GetPageId()) {
+		err := PageRollbackRequestValidationError{
+			field:  "PageId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := PageRollbackRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PageRollbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PageRollbackRequestMultiError is an error wrapping multiple validation
+// errors returned by PageRollbackRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PageRollbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageRollbackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageRollbackRequestMultiError) AllErrors() []error { return m }
+
+// PageRollbackRequestValidationError is the validation error returned by
+// PageRollbackRequest.Validate if the designated constraints aren't met.
+type PageRollbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PageRollbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PageRollbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PageRollbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PageRollbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PageRollbackRequestValidationError) ErrorName() string {
+	return "PageRollbackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PageRollbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPageRollbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PageRollbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PageRollbackRequestValidationError{}
+
+var _PageRollbackRequest_PageId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessObject with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessObject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessObjectMultiError, or
+// nil if none found.
+func (m *AccessObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessObject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
+		err := AccessObjectValidationError{
+			field:  "AccessId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessObject_AccessId_Pattern.MatchString(m.GetAccessId()) {
+		err := AccessObjectValidationError{
+			field:  "AccessId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetProfileId()); l < 3 || l > 40 {
+		err := AccessObjectValidationError{
+			field:  "ProfileId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessObject_ProfileId_Pattern.MatchString(m.GetProfileId()) {
+		err := AccessObjectValidationError{
+			field:  "ProfileId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPartition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessObjectValidationError{
+					field:  "Partition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessObjectValidationError{
+					field:  "Partition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPartition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessObjectValidationError{
+				field:  "Partition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessObjectValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetModifiedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModifiedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessObjectValidationError{
+				field:  "ModifiedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Inherited
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessObjectValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessObjectValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessObjectValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessObjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessObjectMultiError is an error wrapping multiple validation errors
+// returned by AccessObject.ValidateAll() if the designated constraints aren't met.
+type AccessObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessObjectMultiError) AllErrors() []error { return m }
+
+// AccessObjectValidationError is the validation error returned by
+// AccessObject.Validate if the designated constraints aren't met.
+type AccessObjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessObjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessObjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessObjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessObjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessObjectValidationError) ErrorName() string { return "AccessObjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessObjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessObject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessObjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessObjectValidationError{}
+
+var _AccessObject_AccessId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessObject_ProfileId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessCreateRequestMultiError, or nil if none found.
+func (m *AccessCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+		err := AccessCreateRequestValidationError{
+			field:  "PartitionId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessCreateRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := AccessCreateRequestValidationError{
+			field:  "PartitionId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetProfileId()); l < 3 || l > 40 {
+		err := AccessCreateRequestValidationError{
+			field:  "ProfileId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessCreateRequest_ProfileId_Pattern.MatchString(m.GetProfileId()) {
+		err := AccessCreateRequestValidationError{
+			field:  "ProfileId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AccessCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by AccessCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AccessCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessCreateRequestMultiError) AllErrors() []error { return m }
+
+// AccessCreateRequestValidationError is the validation error returned by
+// AccessCreateRequest.Validate if the designated constraints aren't met.
+type AccessCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessCreateRequestValidationError) ErrorName() string {
+	return "AccessCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessCreateRequestValidationError{}
+
+var _AccessCreateRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessCreateRequest_ProfileId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AccessGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessGetRequestMultiError, or nil if none found.
+func (m *AccessGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccessId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
+			err := AccessGetRequestValidationError{
+				field:  "AccessId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AccessGetRequest_AccessId_Pattern.MatchString(m.GetAccessId()) {
+			err := AccessGetRequestValidationError{
+				field:  "AccessId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPartitionId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+			err := AccessGetRequestValidationError{
+				field:  "PartitionId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AccessGetRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+			err := AccessGetRequestValidationError{
+				field:  "PartitionId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetProfileId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetProfileId()); l < 3 || l > 40 {
+			err := AccessGetRequestValidationError{
+				field:  "ProfileId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AccessGetRequest_ProfileId_Pattern.MatchString(m.GetProfileId()) {
+			err := AccessGetRequestValidationError{
+				field:  "ProfileId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for IncludeInherited
+
+	if len(errors) > 0 {
+		return AccessGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessGetRequestMultiError is an error wrapping multiple validation errors
+// returned by AccessGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AccessGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessGetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessGetRequestMultiError) AllErrors() []error { return m }
+
+// AccessGetRequestValidationError is the validation error returned by
+// AccessGetRequest.Validate if the designated constraints aren't met.
+type AccessGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessGetRequestValidationError) ErrorName() string { return "AccessGetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessGetRequestValidationError{}
+
+var _AccessGetRequest_AccessId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessGetRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessGetRequest_ProfileId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessRemoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessRemoveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessRemoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessRemoveRequestMultiError, or nil if none found.
+func (m *AccessRemoveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessRemoveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
+		err := AccessRemoveRequestValidationError{
+			field:  "AccessId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessRemoveRequest_AccessId_Pattern.MatchString(m.GetAccessId()) {
+		err := AccessRemoveRequestValidationError{
+			field:  "AccessId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AccessRemoveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessRemoveRequestMultiError is an error wrapping multiple validation
+// errors returned by AccessRemoveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AccessRemoveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessRemoveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessRemoveRequestMultiError) AllErrors() []error { return m }
+
+// AccessRemoveRequestValidationError is the validation error returned by
+// AccessRemoveRequest.Validate if the designated constraints aren't met.
+type AccessRemoveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessRemoveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessRemoveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessRemoveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessRemoveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessRemoveRequestValidationError) ErrorName() string {
+	return "AccessRemoveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessRemoveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessRemoveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessRemoveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessRemoveRequestValidationError{}
+
+var _AccessRemoveRequest_AccessId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessRoleCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessRoleCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessRoleCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessRoleCreateRequestMultiError, or nil if none found.
+func (m *AccessRoleCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessRoleCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
+		err := AccessRoleCreateRequestValidationError{
+			field:  "AccessId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessRoleCreateRequest_AccessId_Pattern.MatchString(m.GetAccessId()) {
+		err := AccessRoleCreateRequestValidationError{
+			field:  "AccessId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPartitionRoleId()); l < 3 || l > 40 {
+		err := AccessRoleCreateRequestValidationError{
+			field:  "PartitionRoleId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessRoleCreateRequest_PartitionRoleId_Pattern.MatchString(m.GetPartitionRoleId()) {
+		err := AccessRoleCreateRequestValidationError{
+			field:  "PartitionRoleId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AccessRoleCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessRoleCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by AccessRoleCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AccessRoleCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessRoleCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessRoleCreateRequestMultiError) AllErrors() []error { return m }
+
+// AccessRoleCreateRequestValidationError is the validation error returned by
+// AccessRoleCreateRequest.Validate if the designated constraints aren't met.
+type AccessRoleCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessRoleCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessRoleCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessRoleCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessRoleCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessRoleCreateRequestValidationError) ErrorName() string {
+	return "AccessRoleCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessRoleCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessRoleCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessRoleCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessRoleCreateRequestValidationError{}
+
+var _AccessRoleCreateRequest_AccessId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessRoleCreateRequest_PartitionRoleId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessRoleObject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AccessRoleObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessRoleObject with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessRoleObjectMultiError, or nil if none found.
+func (m *AccessRoleObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessRoleObject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAccessRoleId()); l < 3 || l > 40 {
+		err := AccessRoleObjectValidationError{
+			field:  "AccessRoleId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessRoleObject_AccessRoleId_Pattern.MatchString(m.GetAccessRoleId()) {
+		err := AccessRoleObjectValidationError{
+			field:  "AccessRoleId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
+		err := AccessRoleObjectValidationError{
+			field:  "AccessId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessRoleObject_AccessId_Pattern.MatchString(m.GetAccessId()) {
+		err := AccessRoleObjectValidationError{
+			field:  "AccessId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRoleObjectValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessRoleObjectValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRoleObjectValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRoleObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessRoleObjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRoleObjectValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetModifiedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRoleObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessRoleObjectValidationError{
+					field:  "ModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModifiedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRoleObjectValidationError{
+				field:  "ModifiedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AccessRoleObjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessRoleObjectMultiError is an error wrapping multiple validation errors
+// returned by AccessRoleObject.ValidateAll() if the designated constraints
+// aren't met.
+type AccessRoleObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessRoleObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessRoleObjectMultiError) AllErrors() []error { return m }
+
+// AccessRoleObjectValidationError is the validation error returned by
+// AccessRoleObject.Validate if the designated constraints aren't met.
+type AccessRoleObjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessRoleObjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessRoleObjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessRoleObjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessRoleObjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessRoleObjectValidationError) ErrorName() string { return "AccessRoleObjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessRoleObjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessRoleObject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessRoleObjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessRoleObjectValidationError{}
+
+var _AccessRoleObject_AccessRoleId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessRoleObject_AccessId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessRoleRemoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessRoleRemoveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessRoleRemoveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessRoleRemoveRequestMultiError, or nil if none found.
+func (m *AccessRoleRemoveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessRoleRemoveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAccessRoleId()); l < 3 || l > 40 {
+		err := AccessRoleRemoveRequestValidationError{
+			field:  "AccessRoleId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessRoleRemoveRequest_AccessRoleId_Pattern.MatchString(m.GetAccessRoleId()) {
+		err := AccessRoleRemoveRequestValidationError{
+			field:  "AccessRoleId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AccessRoleRemoveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessRoleRemoveRequestMultiError is an error wrapping multiple validation
+// errors returned by AccessRoleRemoveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AccessRoleRemoveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessRoleRemoveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessRoleRemoveRequestMultiError) AllErrors() []error { return m }
+
+// AccessRoleRemoveRequestValidationError is the validation error returned by
+// AccessRoleRemoveRequest.Validate if the designated constraints aren't met.
+type AccessRoleRemoveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessRoleRemoveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessRoleRemoveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessRoleRemoveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessRoleRemoveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessRoleRemoveRequestValidationError) ErrorName() string {
+	return "AccessRoleRemoveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessRoleRemoveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessRoleRemoveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessRoleRemoveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessRoleRemoveRequestValidationError{}
+
+var _AccessRoleRemoveRequest_AccessRoleId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessRoleListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessRoleListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessRoleListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessRoleListRequestMultiError, or nil if none found.
+func (m *AccessRoleListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessRoleListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
+		err := AccessRoleListRequestValidationError{
+			field:  "AccessId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessRoleListRequest_AccessId_Pattern.MatchString(m.GetAccessId()) {
+		err := AccessRoleListRequestValidationError{
+			field:  "AccessId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AccessRoleListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessRoleListRequestMultiError is an error wrapping multiple validation
+// errors returned by AccessRoleListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AccessRoleListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessRoleListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessRoleListRequestMultiError) AllErrors() []error { return m }
+
+// AccessRoleListRequestValidationError is the validation error returned by
+// AccessRoleListRequest.Validate if the designated constraints aren't met.
+type AccessRoleListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessRoleListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessRoleListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessRoleListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessRoleListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessRoleListRequestValidationError) ErrorName() string {
+	return "AccessRoleListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessRoleListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessRoleListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessRoleListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessRoleListRequestValidationError{}
+
+var _AccessRoleListRequest_AccessId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessRoleListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessRoleListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessRoleListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessRoleListResponseMultiError, or nil if none found.
+func (m *AccessRoleListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessRoleListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRole() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessRoleListResponseValidationError{
+						field:  fmt.Sprintf("Role[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessRoleListResponseValidationError{
+						field:  fmt.Sprintf("Role[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessRoleListResponseValidationError{
+					field:  fmt.Sprintf("Role[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessRoleListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessRoleListResponseMultiError is an error wrapping multiple validation
+// errors returned by AccessRoleListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AccessRoleListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessRoleListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessRoleListResponseMultiError) AllErrors() []error { return m }
+
+// AccessRoleListResponseValidationError is the validation error returned by
+// AccessRoleListResponse.Validate if the designated constraints aren't met.
+type AccessRoleListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessRoleListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessRoleListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessRoleListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessRoleListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessRoleListResponseValidationError) ErrorName() string {
+	return "AccessRoleListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessRoleListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessRoleListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessRoleListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessRoleListResponseValidationError{}
+
+// Validate checks the field values on AccessListByProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessListByProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessListByProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessListByProfileRequestMultiError, or nil if none found.
+func (m *AccessListByProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessListByProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetProfileId()); l < 3 || l > 40 {
+		err := AccessListByProfileRequestValidationError{
+			field:  "ProfileId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessListByProfileRequest_ProfileId_Pattern.MatchString(m.GetProfileId()) {
+		err := AccessListByProfileRequestValidationError{
+			field:  "ProfileId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTenantId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetTenantId()); l < 3 || l > 40 {
+			err := AccessListByProfileRequestValidationError{
+				field:  "TenantId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AccessListByProfileRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+			err := AccessListByProfileRequestValidationError{
+				field:  "TenantId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetStates()) > 5 {
+		err := AccessListByProfileRequestValidationError{
+			field:  "States",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AccessListByProfileRequest_States_Unique := make(map[common.STATE]struct{}, len(m.GetStates()))
+
+	for idx, item := range m.GetStates() {
+		_, _ = idx, item
+
+		if _, exists := _AccessListByProfileRequest_States_Unique[item]; exists {
+			err := AccessListByProfileRequestValidationError{
+				field:  fmt.Sprintf("States[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AccessListByProfileRequest_States_Unique[item] = struct{}{}
+		}
+
+		if _, ok := common.STATE_name[int32(item)]; !ok {
+			err := AccessListByProfileRequestValidationError{
+				field:  fmt.Sprintf("States[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for IncludeRoles
+
+	if all {
+		switch v := interface{}(m.GetSearch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessListByProfileRequestValidationError{
+					field:  "Search",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessListByProfileRequestValidationError{
+					field:  "Search",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSearch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessListByProfileRequestValidationError{
+				field:  "Search",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AccessListByProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessListByProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by AccessListByProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AccessListByProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessListByProfileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessListByProfileRequestMultiError) AllErrors() []error { return m }
+
+// AccessListByProfileRequestValidationError is the validation error returned
+// by AccessListByProfileRequest.Validate if the designated constraints aren't met.
+type AccessListByProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessListByProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessListByProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessListByProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessListByProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessListByProfileRequestValidationError) ErrorName() string {
+	return "AccessListByProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessListByProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessListByProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessListByProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessListByProfileRequestValidationError{}
+
+var _AccessListByProfileRequest_ProfileId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessListByProfileRequest_TenantId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on PartitionAccessListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PartitionAccessListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionAccessListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PartitionAccessListRequestMultiError, or nil if none found.
+func (m *PartitionAccessListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionAccessListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+		err := PartitionAccessListRequestValidationError{
+			field:  "PartitionId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PartitionAccessListRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := PartitionAccessListRequestValidationError{
+			field:  "PartitionId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRole() != "" {
+
+		if l := utf8.RuneCountInString(m.GetRole()); l < 3 || l > 100 {
+			err := PartitionAccessListRequestValidationError{
+				field:  "Role",
+				reason: "value length must be between 3 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetStates()) > 5 {
+		err := PartitionAccessListRequestValidationError{
+			field:  "States",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_PartitionAccessListRequest_States_Unique := make(map[common.STATE]struct{}, len(m.GetStates()))
+
+	for idx, item := range m.GetStates() {
+		_, _ = idx, item
+
+		if _, exists := _PartitionAccessListRequest_States_Unique[item]; exists {
+			err := PartitionAccessListRequestValidationError{
+				field:  fmt.Sprintf("States[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_PartitionAccessListRequest_States_Unique[item] = struct{}{}
+		}
+
+		if _, ok := common.STATE_name[int32(item)]; !ok {
+			err := PartitionAccessListRequestValidationError{
+				field:  fmt.Sprintf("States[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := AccessSort_name[int32(m.GetSort())]; !ok {
+		err := PartitionAccessListRequestValidationError{
+			field:  "Sort",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSearch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartitionAccessListRequestValidationError{
+					field:  "Search",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartitionAccessListRequestValidationError{
+					field:  "Search",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSearch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartitionAccessListRequestValidationError{
+				field:  "Search",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PartitionAccessListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PartitionAccessListRequestMultiError is an error wrapping multiple
+// validation errors returned by PartitionAccessListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PartitionAccessListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionAccessListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionAccessListRequestMultiError) AllErrors() []error { return m }
+
+// PartitionAccessListRequestValidationError is the validation error returned
+// by PartitionAccessListRequest.Validate if the designated constraints aren't met.
+type PartitionAccessListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PartitionAccessListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PartitionAccessListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PartitionAccessListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PartitionAccessListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PartitionAccessListRequestValidationError) ErrorName() string {
+	return "PartitionAccessListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PartitionAccessListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPartitionAccessListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PartitionAccessListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PartitionAccessListRequestValidationError{}
+
+var _PartitionAccessListRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessCheckRequestMultiError, or nil if none found.
+func (m *AccessCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+		err := AccessCheckRequestValidationError{
+			field:  "PartitionId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessCheckRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+		err := AccessCheckRequestValidationError{
+			field:  "PartitionId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetProfileId()); l < 3 || l > 40 {
+		err := AccessCheckRequestValidationError{
+			field:  "ProfileId",
+			reason: "value length must be between 3 and 40 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AccessCheckRequest_ProfileId_Pattern.MatchString(m.GetProfileId()) {
+		err := AccessCheckRequestValidationError{
+			field:  "ProfileId",
+			reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRole() != "" {
+
+		if l := utf8.RuneCountInString(m.GetRole()); l < 3 || l > 100 {
+			err := AccessCheckRequestValidationError{
+				field:  "Role",
+				reason: "value length must be between 3 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for IncludeAncestors
+
+	if len(errors) > 0 {
+		return AccessCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessCheckRequestMultiError is an error wrapping multiple validation errors
+// returned by AccessCheckRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AccessCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessCheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessCheckRequestMultiError) AllErrors() []error { return m }
+
+// AccessCheckRequestValidationError is the validation error returned by
+// AccessCheckRequest.Validate if the designated constraints aren't met.
+type AccessCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessCheckRequestValidationError) ErrorName() string {
+	return "AccessCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessCheckRequestValidationError{}
+
+var _AccessCheckRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessCheckRequest_ProfileId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessCheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessCheckResponseMultiError, or nil if none found.
+func (m *AccessCheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessCheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	if m.GetAccessId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetAccessId()); l < 3 || l > 40 {
+			err := AccessCheckResponseValidationError{
+				field:  "AccessId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AccessCheckResponse_AccessId_Pattern.MatchString(m.GetAccessId()) {
+			err := AccessCheckResponseValidationError{
+				field:  "AccessId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetAccessRoleId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetAccessRoleId()); l < 3 || l > 40 {
+			err := AccessCheckResponseValidationError{
+				field:  "AccessRoleId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AccessCheckResponse_AccessRoleId_Pattern.MatchString(m.GetAccessRoleId()) {
+			err := AccessCheckResponseValidationError{
+				field:  "AccessRoleId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPartitionId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+			err := AccessCheckResponseValidationError{
+				field:  "PartitionId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AccessCheckResponse_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+			err := AccessCheckResponseValidationError{
+				field:  "PartitionId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessCheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessCheckResponseMultiError is an error wrapping multiple validation
+// errors returned by AccessCheckResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AccessCheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessCheckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessCheckResponseMultiError) AllErrors() []error { return m }
+
+// AccessCheckResponseValidationError is the validation error returned by
+// AccessCheckResponse.Validate if the designated constraints aren't met.
+type AccessCheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessCheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessCheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessCheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessCheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessCheckResponseValidationError) ErrorName() string {
+	return "AccessCheckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessCheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessCheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessCheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessCheckResponseValidationError{}
+
+var _AccessCheckResponse_AccessId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessCheckResponse_AccessRoleId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _AccessCheckResponse_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on AccessCheckBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessCheckBatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessCheckBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessCheckBatchRequestMultiError, or nil if none found.
+func (m *AccessCheckBatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessCheckBatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetChecks()); l < 1 || l > 100 {
+		err := AccessCheckBatchRequestValidationError{
+			field:  "Checks",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessCheckBatchRequestValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessCheckBatchRequestValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessCheckBatchRequestValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessCheckBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessCheckBatchRequestMultiError is an error wrapping multiple validation
+// errors returned by AccessCheckBatchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AccessCheckBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessCheckBatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessCheckBatchRequestMultiError) AllErrors() []error { return m }
+
+// AccessCheckBatchRequestValidationError is the validation error returned by
+// AccessCheckBatchRequest.Validate if the designated constraints aren't met.
+type AccessCheckBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessCheckBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessCheckBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessCheckBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessCheckBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessCheckBatchRequestValidationError) ErrorName() string {
+	return "AccessCheckBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessCheckBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessCheckBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessCheckBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessCheckBatchRequestValidationError{}
+
+// Validate checks the field values on AccessCheckBatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessCheckBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessCheckBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessCheckBatchResponseMultiError, or nil if none found.
+func (m *AccessCheckBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessCheckBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessCheckBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessCheckBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessCheckBatchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessCheckBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessCheckBatchResponseMultiError is an error wrapping multiple validation
+// errors returned by AccessCheckBatchResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AccessCheckBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessCheckBatchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessCheckBatchResponseMultiError) AllErrors() []error { return m }
+
+// AccessCheckBatchResponseValidationError is the validation error returned by
+// AccessCheckBatchResponse.Validate if the designated constraints aren't met.
+type AccessCheckBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessCheckBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessCheckBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessCheckBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessCheckBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessCheckBatchResponseValidationError) ErrorName() string {
+	return "AccessCheckBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessCheckBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessCheckBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessCheckBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessCheckBatchResponseValidationError{}
+
+// Validate checks the field values on SearchRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchRequestMultiError, or
+// nil if none found.
+func (m *SearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetQuery() != "" {
+
+		if utf8.RuneCountInString(m.GetQuery()) > 100 {
+			err := SearchRequestValidationError{
+				field:  "Query",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetCount() != 0 {
+
+		if val := m.GetCount(); val < 5 || val >= 500 {
+			err := SearchRequestValidationError{
+				field:  "Count",
+				reason: "value must be inside range [5, 500)",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPage() != 0 {
+
+		if m.GetPage() < 1 {
+			err := SearchRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPageToken() != "" {
+
+		if utf8.RuneCountInString(m.GetPageToken()) > 512 {
+			err := SearchRequestValidationError{
+				field:  "PageToken",
+				reason: "value length must be at most 512 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SearchRequest_PageToken_Pattern.MatchString(m.GetPageToken()) {
+			err := SearchRequestValidationError{
+				field:  "PageToken",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRequestMultiError) AllErrors() []error { return m }
+
+// SearchRequestValidationError is the validation error returned by
+// SearchRequest.Validate if the designated constraints aren't met.
+type SearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRequestValidationError) ErrorName() string { return "SearchRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRequestValidationError{}
+
+var _SearchRequest_PageToken_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on WatchRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchRequestMultiError, or
+// nil if none found.
+func (m *WatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AfterSequence
+
+	_WatchRequest_Resources_Unique := make(map[ResourceType]struct{}, len(m.GetResources()))
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if _, exists := _WatchRequest_Resources_Unique[item]; exists {
+			err := WatchRequestValidationError{
+				field:  fmt.Sprintf("Resources[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_WatchRequest_Resources_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _WatchRequest_Resources_NotInLookup[item]; ok {
+			err := WatchRequestValidationError{
+				field:  fmt.Sprintf("Resources[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := ResourceType_name[int32(item)]; !ok {
+			err := WatchRequestValidationError{
+				field:  fmt.Sprintf("Resources[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPartitionId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+			err := WatchRequestValidationError{
+				field:  "PartitionId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_WatchRequest_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+			err := WatchRequestValidationError{
+				field:  "PartitionId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Resume
+
+	if len(errors) > 0 {
+		return WatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchRequest.ValidateAll() if the designated constraints aren't met.
+type WatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRequestMultiError) AllErrors() []error { return m }
+
+// WatchRequestValidationError is the validation error returned by
+// WatchRequest.Validate if the designated constraints aren't met.
+type WatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRequestValidationError) ErrorName() string { return "WatchRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRequestValidationError{}
+
+var _WatchRequest_Resources_NotInLookup = map[ResourceType]struct{}{
+	0: {},
+}
+
+var _WatchRequest_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+// Validate checks the field values on ChangeEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChangeEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChangeEventMultiError, or
+// nil if none found.
+func (m *ChangeEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Change
+
+	// no validation rules for Resource
+
+	if m.GetId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetId()); l < 3 || l > 40 {
+			err := ChangeEventValidationError{
+				field:  "Id",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_ChangeEvent_Id_Pattern.MatchString(m.GetId()) {
+			err := ChangeEventValidationError{
+				field:  "Id",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetTenantId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetTenantId()); l < 3 || l > 40 {
+			err := ChangeEventValidationError{
+				field:  "TenantId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_ChangeEvent_TenantId_Pattern.MatchString(m.GetTenantId()) {
+			err := ChangeEventValidationError{
+				field:  "TenantId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPartitionId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetPartitionId()); l < 3 || l > 40 {
+			err := ChangeEventValidationError{
+				field:  "PartitionId",
+				reason: "value length must be between 3 and 40 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_ChangeEvent_PartitionId_Pattern.MatchString(m.GetPartitionId()) {
+			err := ChangeEventValidationError{
+				field:  "PartitionId",
+				reason: "value does not match regex pattern \"^[0-9a-z_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch m.Object.(type) {
+
+	case *ChangeEvent_Tenant:
+
+		if all {
+			switch v := interface{}(m.GetTenant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "Tenant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "Tenant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeEventValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeEvent_Partition:
+
+		if all {
+			switch v := interface{}(m.GetPartition()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "Partition",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "Partition",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPartition()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeEventValidationError{
+					field:  "Partition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeEvent_PartitionRole:
+
+		if all {
+			switch v := interface{}(m.GetPartitionRole()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "PartitionRole",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "PartitionRole",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPartitionRole()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeEventValidationError{
+					field:  "PartitionRole",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeEvent_Page:
+
+		if all {
+			switch v := interface{}(m.GetPage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "Page",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "Page",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeEventValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeEvent_Access:
+
+		if all {
+			switch v := interface{}(m.GetAccess()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "Access",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "Access",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccess()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeEventValidationError{
+					field:  "Access",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeEvent_AccessRole:
+
+		if all {
+			switch v := interface{}(m.GetAccessRole()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "AccessRole",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeEventValidationError{
+						field:  "AccessRole",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccessRole()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeEventValidationError{
+					field:  "AccessRole",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ChangeEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeEventMultiError is an error wrapping multiple validation errors
+// returned by ChangeEvent.ValidateAll() if the designated constraints aren't met.
+type ChangeEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeEventMultiError) AllErrors() []error { return m }
+
+// ChangeEventValidationError is the validation error returned by
+// ChangeEvent.Validate if the designated constraints aren't met.
+type ChangeEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeEventValidationError) ErrorName() string { return "ChangeEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChangeEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeEventValidationError{}
+
+var _ChangeEvent_Id_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _ChangeEvent_TenantId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
+
+var _ChangeEvent_PartitionId_Pattern = regexp.MustCompile("^[0-9a-z_-]+$")
@@ -0,0 +1,1567 @@
+package partitionv1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	apic "github.com/antinvestor/apis"
+	"github.com/antinvestor/apis/common"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	fakeBufferSize         = 1024 * 1024
+	fakeDefaultSearchCount = 20
+
+	fakeIdempotencyRetention = time.Hour * 24
+)
+
+// idEncoding produces 20 character lowercase ids in the same alphabet as the xids issued by the service.
+var idEncoding = base32.NewEncoding("0123456789abcdefghijklmnopqrstuv").WithPadding(base32.NoPadding)
+
+// FakePartitionServiceServer is a stateful in memory implementation of PartitionServiceServer.
+// It keeps tenants, partitions, roles, pages and access in maps and enforces the same
+// request validation rules as the service, which makes it suitable for hermetic tests.
+// Methods may be called concurrently.
+type FakePartitionServiceServer struct {
+	UnimplementedPartitionServiceServer
+
+	mu sync.RWMutex
+
+	tenants        map[string]*TenantObject
+	partitions     map[string]*PartitionObject
+	partitionRoles map[string]*PartitionRoleObject
+	pages          map[string]*fakePage
+	access         map[string]*AccessObject
+	accessRoles    map[string]*AccessRoleObject
+
+	// Creation order of tenants, partitions and access, used for stable paging.
+	tenantOrder    []string
+	partitionOrder []string
+	accessOrder    []string
+
+	// Responses of creates made with an idempotency key, replayed for repeated requests
+	// with the same key until the retention passes.
+	idempotencyMu        sync.Mutex
+	idempotentResponses  map[string]*fakeIdempotentResponse
+	idempotencyRetention time.Duration
+
+	// Change events kept for watches, sequence is the number of the last event published.
+	events        []*ChangeEvent
+	sequence      uint64
+	eventsChanged chan struct{}
+	// watchHeartbeat is how often watches report a position moved past changes they did not send.
+	watchHeartbeat time.Duration
+
+	grpcServer *grpc.Server
+	listener   *bufconn.Listener
+}
+
+type fakeIdempotentResponse struct {
+	request  proto.Message
+	response proto.Message
+	expires  time.Time
+}
+
+type fakePage struct {
+	partitionId string
+	page        *PageObject
+	// versions holds every revision of the html from the first.
+	versions []*PageVersion
+}
+
+// NewFakePartitionServiceServer creates an empty in memory partition service.
+func NewFakePartitionServiceServer() *FakePartitionServiceServer {
+	return &FakePartitionServiceServer{
+		tenants:        map[string]*TenantObject{},
+		partitions:     map[string]*PartitionObject{},
+		partitionRoles: map[string]*PartitionRoleObject{},
+		pages:          map[string]*fakePage{},
+		access:         map[string]*AccessObject{},
+		accessRoles:    map[string]*AccessRoleObject{},
+
+		idempotentResponses:  map[string]*fakeIdempotentResponse{},
+		idempotencyRetention: fakeIdempotencyRetention,
+
+		eventsChanged:  make(chan struct{}),
+		watchHeartbeat: fakeWatchHeartbeat,
+	}
+}
+
+// Start serves the fake on an in memory bufconn listener, calling it more than once has no effect.
+func (fs *FakePartitionServiceServer) Start() {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if fs.grpcServer != nil {
+		return
+	}
+
+	fs.listener = bufconn.Listen(fakeBufferSize)
+	fs.grpcServer = grpc.NewServer()
+	RegisterPartitionServiceServer(fs.grpcServer, fs)
+
+	go func(srv *grpc.Server, lis net.Listener) {
+		_ = srv.Serve(lis)
+	}(fs.grpcServer, fs.listener)
+}
+
+// Stop terminates the in memory server and closes all connections to it.
+func (fs *FakePartitionServiceServer) Stop() {
+	fs.mu.Lock()
+	srv := fs.grpcServer
+	fs.grpcServer = nil
+	fs.listener = nil
+	fs.mu.Unlock()
+
+	if srv != nil {
+		srv.Stop()
+	}
+}
+
+// Dial opens a client connection to the in memory server, starting it if required.
+func (fs *FakePartitionServiceServer) Dial(ctx context.Context, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	fs.Start()
+
+	fs.mu.RLock()
+	lis := fs.listener
+	fs.mu.RUnlock()
+
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, opts...)
+
+	return grpc.DialContext(ctx, "bufconn", dialOpts...)
+}
+
+// NewFakePartitionsClient creates a partitions client that talks to the supplied fake without any network.
+func NewFakePartitionsClient(ctx context.Context, fs *FakePartitionServiceServer,
+	opts ...apic.ClientOption) (*PartitionClient, error) {
+	conn, err := fs.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return InstantiatePartitionsClient(conn, NewPartitionServiceClient(conn), opts...), nil
+}
+
+func newFakeId() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return idEncoding.EncodeToString(b)
+}
+
+func copyProperties(props map[string]string) map[string]string {
+	if props == nil {
+		return nil
+	}
+
+	cp := make(map[string]string, len(props))
+	for k, v := range props {
+		cp[k] = v
+	}
+	return cp
+}
+
+func validateRequest(req interface{ ValidateAll() error }) error {
+	err := req.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range FieldViolations(err) {
+		badRequest.FieldViolations = append(badRequest.FieldViolations,
+			&errdetails.BadRequest_FieldViolation{Field: violation.Field, Description: violation.Reason})
+	}
+	return withDetails(status.New(codes.InvalidArgument, err.Error()), badRequest)
+}
+
+// resourceError builds a status error carrying the ResourceInfo of the object the failure concerns.
+func resourceError(code codes.Code, resourceType string, resourceName string, format string, args ...interface{}) error {
+	return withDetails(status.Newf(code, format, args...),
+		&errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: resourceName})
+}
+
+// templateError rejects page html that is not a valid template.
+func templateError(err error) error {
+	return withDetails(status.New(codes.InvalidArgument, err.Error()),
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "Html", Description: err.Error()}}})
+}
+
+func withDetails(st *status.Status, details ...protoiface.MessageV1) error {
+	if detailed, err := st.WithDetails(details...); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// replayIdempotent runs create for requests without an idempotency key. The response to a request with a key
+// is kept for the retention window and returned again when the same request is repeated with that key,
+// reusing the key for a different request is rejected.
+func replayIdempotent[Req proto.Message, Resp proto.Message](
+	fs *FakePartitionServiceServer,
+	ctx context.Context,
+	method string,
+	req Req,
+	create func(Req) (Resp, error)) (Resp, error) {
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(IdempotencyKeyHeader)
+	if len(keys) == 0 || keys[0] == "" {
+		return create(req)
+	}
+
+	fs.idempotencyMu.Lock()
+	defer fs.idempotencyMu.Unlock()
+
+	now := time.Now()
+	for key, stored := range fs.idempotentResponses {
+		if now.After(stored.expires) {
+			delete(fs.idempotentResponses, key)
+		}
+	}
+
+	key := method + "/" + keys[0]
+	if stored, ok := fs.idempotentResponses[key]; ok {
+		var empty Resp
+		if !proto.Equal(stored.request, req) {
+			return empty, status.Errorf(codes.FailedPrecondition,
+				"idempotency key %s was already used for a different %s request", keys[0], method)
+		}
+		return proto.Clone(stored.response).(Resp), nil
+	}
+
+	response, err := create(req)
+	if err != nil {
+		return response, err
+	}
+
+	fs.idempotentResponses[key] = &fakeIdempotentResponse{
+		request:  proto.Clone(req),
+		response: proto.Clone(response),
+		expires:  now.Add(fs.idempotencyRetention),
+	}
+	return response, nil
+}
+
+func matchesQuery(query string, values []string, props map[string]string) bool {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return true
+	}
+
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), query) {
+			return true
+		}
+	}
+
+	for k, v := range props {
+		if strings.Contains(strings.ToLower(k), query) || strings.Contains(strings.ToLower(v), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchWindow selects the matched objects to send for a search request and the token of the page after them.
+// positions holds the creation order position of every matched object, page tokens encode such a position
+// so that cursors stay stable when objects are created while a listing is in progress.
+func searchWindow(req *SearchRequest, positions []int) (int, int, string, error) {
+	count := int(req.GetCount())
+	if count == 0 {
+		count = fakeDefaultSearchCount
+	}
+
+	var start int
+	if req.GetPageToken() != "" {
+		position, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return 0, 0, "", err
+		}
+		start = sort.SearchInts(positions, position)
+	} else {
+		page := int(req.GetPage())
+		if page < 1 {
+			page = 1
+		}
+
+		start = (page - 1) * count
+		if start > len(positions) {
+			start = len(positions)
+		}
+	}
+
+	end := start + count
+	if end >= len(positions) {
+		return start, len(positions), "", nil
+	}
+	return start, end, encodePageToken(positions[end-1] + 1), nil
+}
+
+func encodePageToken(position int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(position)))
+}
+
+func decodePageToken(token string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "page token is not valid")
+	}
+
+	position, err := strconv.Atoi(string(raw))
+	if err != nil || position < 0 {
+		return 0, status.Error(codes.InvalidArgument, "page token is not valid")
+	}
+	return position, nil
+}
+
+// sendNextPageToken records the token of the following page in the stream trailer.
+func sendNextPageToken(stream grpc.ServerStream, token string) {
+	if token != "" {
+		stream.SetTrailer(metadata.Pairs(NextPageTokenKey, token))
+	}
+}
+
+func (fs *FakePartitionServiceServer) GetTenant(_ context.Context, req *GetRequest) (*TenantObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	tenant, ok := fs.tenants[req.GetId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "tenant", req.GetId(), "tenant %s not found", req.GetId())
+	}
+	return proto.Clone(tenant).(*TenantObject), nil
+}
+
+func (fs *FakePartitionServiceServer) ListTenant(req *SearchRequest, stream PartitionService_ListTenantServer) error {
+	if err := validateRequest(req); err != nil {
+		return err
+	}
+
+	fs.mu.RLock()
+	var matched []*TenantObject
+	var positions []int
+	for position, id := range fs.tenantOrder {
+		tenant := fs.tenants[id]
+		if matchesQuery(req.GetQuery(), []string{tenant.GetTenantId(), tenant.GetName(), tenant.GetDescription()}, tenant.GetProperties()) {
+			matched = append(matched, proto.Clone(tenant).(*TenantObject))
+			positions = append(positions, position)
+		}
+	}
+	fs.mu.RUnlock()
+
+	start, end, nextPageToken, err := searchWindow(req, positions)
+	if err != nil {
+		return err
+	}
+
+	for _, tenant := range matched[start:end] {
+		if err := stream.Send(tenant); err != nil {
+			return err
+		}
+	}
+
+	sendNextPageToken(stream, nextPageToken)
+	return nil
+}
+
+func (fs *FakePartitionServiceServer) CreateTenant(ctx context.Context, req *TenantRequest) (*TenantObject, error) {
+	return replayIdempotent(fs, ctx, "CreateTenant", req, fs.createTenant)
+}
+
+func (fs *FakePartitionServiceServer) createTenant(req *TenantRequest) (*TenantObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.Now()
+	tenant := &TenantObject{
+		TenantId:    newFakeId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		State:       common.STATE_ACTIVE,
+		Properties:  copyProperties(req.GetProperties()),
+		CreatedAt:   now,
+		ModifiedAt:  now,
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.tenants[tenant.GetTenantId()] = tenant
+	fs.tenantOrder = append(fs.tenantOrder, tenant.GetTenantId())
+	fs.publish(ChangeType_CHANGE_CREATED, tenant)
+	return proto.Clone(tenant).(*TenantObject), nil
+}
+
+// UpdateTenant replaces the name and description of a tenant, the state is only
+// changed when a non default state is supplied and properties only when some are supplied.
+func (fs *FakePartitionServiceServer) UpdateTenant(_ context.Context, req *TenantUpdateRequest) (*TenantObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	tenant, ok := fs.tenants[req.GetTenantId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "tenant", req.GetTenantId(), "tenant %s not found", req.GetTenantId())
+	}
+
+	tenant.Name = req.GetName()
+	tenant.Description = req.GetDescription()
+	if req.GetState() != common.STATE_CREATED {
+		tenant.State = req.GetState()
+	}
+	if len(req.GetProperties()) > 0 {
+		tenant.Properties = copyProperties(req.GetProperties())
+	}
+	tenant.ModifiedAt = timestamppb.Now()
+
+	fs.publish(ChangeType_CHANGE_UPDATED, tenant)
+	return proto.Clone(tenant).(*TenantObject), nil
+}
+
+func (fs *FakePartitionServiceServer) SuspendTenant(_ context.Context, req *GetRequest) (*TenantObject, error) {
+	return fs.changeTenantState(req, common.STATE_INACTIVE)
+}
+
+func (fs *FakePartitionServiceServer) ReactivateTenant(_ context.Context, req *GetRequest) (*TenantObject, error) {
+	return fs.changeTenantState(req, common.STATE_ACTIVE)
+}
+
+func (fs *FakePartitionServiceServer) changeTenantState(req *GetRequest, state common.STATE) (*TenantObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	tenant, ok := fs.tenants[req.GetId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "tenant", req.GetId(), "tenant %s not found", req.GetId())
+	}
+
+	if tenant.GetState() == common.STATE_DELETED {
+		return nil, status.Errorf(codes.FailedPrecondition, "tenant %s is deleted", req.GetId())
+	}
+
+	tenant.State = state
+	tenant.ModifiedAt = timestamppb.Now()
+	fs.publish(ChangeType_CHANGE_UPDATED, tenant)
+	return proto.Clone(tenant).(*TenantObject), nil
+}
+
+func (fs *FakePartitionServiceServer) ListPartition(req *SearchRequest, stream PartitionService_ListPartitionServer) error {
+	if err := validateRequest(req); err != nil {
+		return err
+	}
+
+	fs.mu.RLock()
+	var matched []*PartitionObject
+	var positions []int
+	for position, id := range fs.partitionOrder {
+		partition := fs.partitions[id]
+		values := []string{partition.GetPartitionId(), partition.GetName(), partition.GetDescription()}
+		if matchesQuery(req.GetQuery(), values, partition.GetProperties()) {
+			matched = append(matched, proto.Clone(partition).(*PartitionObject))
+			positions = append(positions, position)
+		}
+	}
+	fs.mu.RUnlock()
+
+	start, end, nextPageToken, err := searchWindow(req, positions)
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range matched[start:end] {
+		if err := stream.Send(partition); err != nil {
+			return err
+		}
+	}
+
+	sendNextPageToken(stream, nextPageToken)
+	return nil
+}
+
+func (fs *FakePartitionServiceServer) CreatePartition(ctx context.Context, req *PartitionCreateRequest) (*PartitionObject, error) {
+	return replayIdempotent(fs, ctx, "CreatePartition", req, fs.createPartition)
+}
+
+func (fs *FakePartitionServiceServer) createPartition(req *PartitionCreateRequest) (*PartitionObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	tenant, ok := fs.tenants[req.GetTenantId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "tenant", req.GetTenantId(), "tenant %s not found", req.GetTenantId())
+	}
+
+	if tenant.GetState() != common.STATE_ACTIVE {
+		return nil, status.Errorf(codes.FailedPrecondition, "tenant %s is not active", req.GetTenantId())
+	}
+
+	if req.GetParentId() != "" {
+		parent, ok := fs.partitions[req.GetParentId()]
+		if !ok {
+			return nil, resourceError(codes.NotFound, "partition", req.GetParentId(), "parent partition %s not found", req.GetParentId())
+		}
+		if parent.GetTenantId() != req.GetTenantId() {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"parent partition %s belongs to a different tenant", req.GetParentId())
+		}
+	}
+
+	now := timestamppb.Now()
+	partition := &PartitionObject{
+		PartitionId: newFakeId(),
+		Name:        req.GetName(),
+		TenantId:    req.GetTenantId(),
+		ParentId:    req.GetParentId(),
+		Description: req.GetDescription(),
+		State:       common.STATE_ACTIVE,
+		Properties:  copyProperties(req.GetProperties()),
+		CreatedAt:   now,
+		ModifiedAt:  now,
+	}
+
+	fs.partitions[partition.GetPartitionId()] = partition
+	fs.partitionOrder = append(fs.partitionOrder, partition.GetPartitionId())
+	fs.publish(ChangeType_CHANGE_CREATED, partition)
+	return proto.Clone(partition).(*PartitionObject), nil
+}
+
+func (fs *FakePartitionServiceServer) GetPartition(_ context.Context, req *GetRequest) (*PartitionObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	partition, ok := fs.partitions[req.GetId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetId(), "partition %s not found", req.GetId())
+	}
+	return proto.Clone(partition).(*PartitionObject), nil
+}
+
+// UpdatePartition replaces the name and description of a partition, the state is only
+// changed when a non default state is supplied and properties only when some are supplied.
+func (fs *FakePartitionServiceServer) UpdatePartition(_ context.Context, req *PartitionUpdateRequest) (*PartitionObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	partition, ok := fs.partitions[req.GetPartitionId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	partition.Name = req.GetName()
+	partition.ModifiedAt = timestamppb.Now()
+	partition.Description = req.GetDescription()
+	if req.GetState() != common.STATE_CREATED {
+		partition.State = req.GetState()
+	}
+	if len(req.GetProperties()) > 0 {
+		partition.Properties = copyProperties(req.GetProperties())
+	}
+
+	fs.publish(ChangeType_CHANGE_UPDATED, partition)
+	return proto.Clone(partition).(*PartitionObject), nil
+}
+
+// MovePartition re-parents a partition, access and roles refer to partitions by id so they are kept intact.
+func (fs *FakePartitionServiceServer) MovePartition(_ context.Context, req *PartitionMoveRequest) (*PartitionObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	partition, ok := fs.partitions[req.GetPartitionId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	tenantId := req.GetTenantId()
+	if tenantId == "" {
+		tenantId = partition.GetTenantId()
+	}
+
+	tenant, ok := fs.tenants[tenantId]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "tenant", tenantId, "tenant %s not found", tenantId)
+	}
+	if tenant.GetState() != common.STATE_ACTIVE {
+		return nil, status.Errorf(codes.FailedPrecondition, "tenant %s is not active", tenantId)
+	}
+
+	if req.GetParentId() != "" {
+		parent, ok := fs.partitions[req.GetParentId()]
+		if !ok {
+			return nil, resourceError(codes.NotFound, "partition", req.GetParentId(), "parent partition %s not found", req.GetParentId())
+		}
+		if parent.GetTenantId() != tenantId {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"parent partition %s belongs to a different tenant", req.GetParentId())
+		}
+		if fs.isDescendant(req.GetParentId(), partition.GetPartitionId()) {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"partition %s can not be moved under itself or its descendant %s", partition.GetPartitionId(), req.GetParentId())
+		}
+	}
+
+	now := timestamppb.Now()
+	if req.GetIncludeSubtree() {
+		for _, id := range fs.descendantIds(partition.GetPartitionId()) {
+			descendant := fs.partitions[id]
+			if descendant.GetTenantId() != tenantId {
+				descendant.TenantId = tenantId
+				descendant.ModifiedAt = now
+				fs.publish(ChangeType_CHANGE_UPDATED, descendant)
+			}
+		}
+	} else {
+		for _, id := range fs.partitionOrder {
+			child := fs.partitions[id]
+			if child.GetParentId() == partition.GetPartitionId() {
+				child.ParentId = partition.GetParentId()
+				child.ModifiedAt = now
+				fs.publish(ChangeType_CHANGE_UPDATED, child)
+			}
+		}
+	}
+
+	partition.ParentId = req.GetParentId()
+	partition.TenantId = tenantId
+	partition.ModifiedAt = now
+	fs.publish(ChangeType_CHANGE_UPDATED, partition)
+	return proto.Clone(partition).(*PartitionObject), nil
+}
+
+// isDescendant reports whether partitionId is ancestorId or lies under it. The caller must hold the lock.
+func (fs *FakePartitionServiceServer) isDescendant(partitionId string, ancestorId string) bool {
+	for id := partitionId; id != ""; {
+		if id == ancestorId {
+			return true
+		}
+		partition, ok := fs.partitions[id]
+		if !ok {
+			return false
+		}
+		id = partition.GetParentId()
+	}
+	return false
+}
+
+// descendantIds returns the ids of all partitions under partitionId. The caller must hold the lock.
+func (fs *FakePartitionServiceServer) descendantIds(partitionId string) []string {
+	var ids []string
+	for _, id := range fs.partitionOrder {
+		if id != partitionId && fs.isDescendant(id, partitionId) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (fs *FakePartitionServiceServer) ListChildPartitions(req *GetRequest, stream PartitionService_ListChildPartitionsServer) error {
+	if err := validateRequest(req); err != nil {
+		return err
+	}
+
+	fs.mu.RLock()
+	if _, ok := fs.partitions[req.GetId()]; !ok {
+		fs.mu.RUnlock()
+		return resourceError(codes.NotFound, "partition", req.GetId(), "partition %s not found", req.GetId())
+	}
+	children := fs.childPartitions(req.GetId())
+	fs.mu.RUnlock()
+
+	for _, child := range children {
+		if err := stream.Send(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fs *FakePartitionServiceServer) GetPartitionAncestors(_ context.Context, req *GetRequest) (*PartitionListResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	partition, ok := fs.partitions[req.GetId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetId(), "partition %s not found", req.GetId())
+	}
+
+	response := &PartitionListResponse{}
+	for _, parent := range fs.partitionChain(partition)[1:] {
+		response.Partition = append(response.Partition, proto.Clone(parent).(*PartitionObject))
+	}
+	return response, nil
+}
+
+// partitionChain returns the partition followed by its ancestors up to the root. The caller must hold the lock.
+func (fs *FakePartitionServiceServer) partitionChain(partition *PartitionObject) []*PartitionObject {
+	chain := []*PartitionObject{partition}
+	for parentId := partition.GetParentId(); parentId != ""; {
+		parent, ok := fs.partitions[parentId]
+		if !ok {
+			break
+		}
+		chain = append(chain, parent)
+		parentId = parent.GetParentId()
+	}
+	return chain
+}
+
+// GetPartitionSubtree streams the partition followed by its descendants level by level.
+func (fs *FakePartitionServiceServer) GetPartitionSubtree(req *PartitionSubtreeRequest, stream PartitionService_GetPartitionSubtreeServer) error {
+	if err := validateRequest(req); err != nil {
+		return err
+	}
+
+	fs.mu.RLock()
+	root, ok := fs.partitions[req.GetPartitionId()]
+	if !ok {
+		fs.mu.RUnlock()
+		return resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	subtree := []*PartitionObject{proto.Clone(root).(*PartitionObject)}
+	level := subtree
+	for depth := uint32(1); len(level) > 0 && (req.GetDepth() == 0 || depth <= req.GetDepth()); depth++ {
+		var next []*PartitionObject
+		for _, partition := range level {
+			next = append(next, fs.childPartitions(partition.GetPartitionId())...)
+		}
+		subtree = append(subtree, next...)
+		level = next
+	}
+	fs.mu.RUnlock()
+
+	for _, partition := range subtree {
+		if err := stream.Send(partition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// childPartitions returns copies of the direct children of a partition in creation order.
+// The caller must hold the lock.
+func (fs *FakePartitionServiceServer) childPartitions(partitionId string) []*PartitionObject {
+	var children []*PartitionObject
+	for _, id := range fs.partitionOrder {
+		partition := fs.partitions[id]
+		if partition.GetParentId() == partitionId {
+			children = append(children, proto.Clone(partition).(*PartitionObject))
+		}
+	}
+	return children
+}
+
+func (fs *FakePartitionServiceServer) CreatePartitionRole(ctx context.Context, req *PartitionRoleCreateRequest) (*PartitionRoleObject, error) {
+	return replayIdempotent(fs, ctx, "CreatePartitionRole", req, fs.createPartitionRole)
+}
+
+func (fs *FakePartitionServiceServer) createPartitionRole(req *PartitionRoleCreateRequest) (*PartitionRoleObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if _, ok := fs.partitions[req.GetPartitionId()]; !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	for _, role := range fs.partitionRoles {
+		if role.GetPartitionId() == req.GetPartitionId() && role.GetName() == req.GetName() {
+			return nil, resourceError(codes.AlreadyExists, "partition role", role.GetPartitionRoleId(),
+				"partition role %s already exists on partition %s", req.GetName(), req.GetPartitionId())
+		}
+	}
+
+	now := timestamppb.Now()
+	role := &PartitionRoleObject{
+		PartitionRoleId: newFakeId(),
+		PartitionId:     req.GetPartitionId(),
+		Name:            req.GetName(),
+		Properties:      copyProperties(req.GetProperties()),
+		CreatedAt:       now,
+		ModifiedAt:      now,
+		NonInheritable:  req.GetNonInheritable(),
+	}
+
+	fs.partitionRoles[role.GetPartitionRoleId()] = role
+	fs.publish(ChangeType_CHANGE_CREATED, role)
+	return proto.Clone(role).(*PartitionRoleObject), nil
+}
+
+func (fs *FakePartitionServiceServer) ListPartitionRoles(_ context.Context, req *PartitionRoleListRequest) (*PartitionRoleListResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	if _, ok := fs.partitions[req.GetPartitionId()]; !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	response := &PartitionRoleListResponse{}
+	for _, role := range fs.partitionRoles {
+		if role.GetPartitionId() == req.GetPartitionId() {
+			response.Role = append(response.Role, proto.Clone(role).(*PartitionRoleObject))
+		}
+	}
+
+	sort.Slice(response.Role, func(i, j int) bool { return response.Role[i].GetName() < response.Role[j].GetName() })
+	return response, nil
+}
+
+// RemovePartitionRole deletes the role together with every access role that granted it.
+func (fs *FakePartitionServiceServer) RemovePartitionRole(_ context.Context, req *PartitionRoleRemoveRequest) (*RemoveResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	role, ok := fs.partitionRoles[req.GetPartitionRoleId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "partition role", req.GetPartitionRoleId(), "partition role %s not found", req.GetPartitionRoleId())
+	}
+
+	for id, accessRole := range fs.accessRoles {
+		if accessRole.GetRole().GetPartitionRoleId() == req.GetPartitionRoleId() {
+			delete(fs.accessRoles, id)
+			fs.publish(ChangeType_CHANGE_REMOVED, accessRole)
+		}
+	}
+	delete(fs.partitionRoles, req.GetPartitionRoleId())
+	fs.publish(ChangeType_CHANGE_REMOVED, role)
+
+	return &RemoveResponse{Succeeded: true}, nil
+}
+
+func (fs *FakePartitionServiceServer) CreatePage(ctx context.Context, req *PageCreateRequest) (*PageObject, error) {
+	return replayIdempotent(fs, ctx, "CreatePage", req, fs.createPage)
+}
+
+func (fs *FakePartitionServiceServer) createPage(req *PageCreateRequest) (*PageObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+	if req.GetTemplate() {
+		if err := ValidatePageTemplate(req.GetHtml()); err != nil {
+			return nil, templateError(err)
+		}
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if _, ok := fs.partitions[req.GetPartitionId()]; !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	if p := fs.findPage(req.GetPartitionId(), req.GetName()); p != nil {
+		return nil, resourceError(codes.AlreadyExists, "page", p.page.GetPageId(),
+			"page %s already exists on partition %s", req.GetName(), req.GetPartitionId())
+	}
+
+	now := timestamppb.Now()
+	page := &PageObject{
+		PageId:     newFakeId(),
+		Name:       req.GetName(),
+		Html:       req.GetHtml(),
+		State:      common.STATE_ACTIVE,
+		CreatedAt:  now,
+		ModifiedAt: now,
+		Version:    1,
+		Template:   req.GetTemplate(),
+	}
+
+	fs.pages[page.GetPageId()] = &fakePage{
+		partitionId: req.GetPartitionId(),
+		page:        page,
+		versions:    []*PageVersion{{Version: 1, Html: page.GetHtml(), CreatedAt: now}},
+	}
+	fs.publish(ChangeType_CHANGE_CREATED, fs.pages[page.GetPageId()])
+	return proto.Clone(page).(*PageObject), nil
+}
+
+// GetPage finds a page either by its id or by the partition it belongs to and its name.
+func (fs *FakePartitionServiceServer) GetPage(_ context.Context, req *PageGetRequest) (*PageObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	if req.GetPageId() != "" {
+		p, ok := fs.pages[req.GetPageId()]
+		if !ok {
+			return nil, resourceError(codes.NotFound, "page", req.GetPageId(), "page %s not found", req.GetPageId())
+		}
+		return proto.Clone(p.page).(*PageObject), nil
+	}
+
+	if req.GetPartitionId() == "" || req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "either a page id or a partition id and name are required")
+	}
+
+	if p := fs.findPage(req.GetPartitionId(), req.GetName()); p != nil {
+		return proto.Clone(p.page).(*PageObject), nil
+	}
+	return nil, resourceError(codes.NotFound, "page", req.GetName(),
+		"page %s not found on partition %s", req.GetName(), req.GetPartitionId())
+}
+
+// findPage returns the page of a partition with the given name if any. The caller must hold the lock.
+func (fs *FakePartitionServiceServer) findPage(partitionId string, name string) *fakePage {
+	for _, p := range fs.pages {
+		if p.partitionId == partitionId && p.page.GetName() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func (fs *FakePartitionServiceServer) RemovePage(_ context.Context, req *PageRemoveRequest) (*RemoveResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	p, ok := fs.pages[req.GetPageId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "page", req.GetPageId(), "page %s not found", req.GetPageId())
+	}
+
+	delete(fs.pages, req.GetPageId())
+	fs.publish(ChangeType_CHANGE_REMOVED, p)
+	return &RemoveResponse{Succeeded: true}, nil
+}
+
+func (fs *FakePartitionServiceServer) ListPages(_ context.Context, req *PageListRequest) (*PageListResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	if _, ok := fs.partitions[req.GetPartitionId()]; !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	response := &PageListResponse{}
+	for _, p := range fs.pages {
+		if p.partitionId == req.GetPartitionId() {
+			response.Page = append(response.Page, proto.Clone(p.page).(*PageObject))
+		}
+	}
+
+	sort.Slice(response.Page, func(i, j int) bool {
+		return response.Page[i].GetName() < response.Page[j].GetName()
+	})
+	return response, nil
+}
+
+// UpdatePage changes a page in place, a change of its html is recorded as a new version.
+func (fs *FakePartitionServiceServer) UpdatePage(_ context.Context, req *PageUpdateRequest) (*PageObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	p, ok := fs.pages[req.GetPageId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "page", req.GetPageId(), "page %s not found", req.GetPageId())
+	}
+
+	if p.page.GetTemplate() {
+		if err := ValidatePageTemplate(req.GetHtml()); err != nil {
+			return nil, templateError(err)
+		}
+	}
+
+	for _, other := range fs.pages {
+		if other != p && other.partitionId == p.partitionId && other.page.GetName() == req.GetName() {
+			return nil, resourceError(codes.AlreadyExists, "page", other.page.GetPageId(),
+				"page %s already exists on partition %s", req.GetName(), p.partitionId)
+		}
+	}
+
+	p.page.Name = req.GetName()
+	if req.GetState() != common.STATE_CREATED {
+		p.page.State = req.GetState()
+	}
+	if req.GetHtml() != p.page.GetHtml() {
+		p.addVersion(req.GetHtml())
+	}
+	p.page.ModifiedAt = timestamppb.Now()
+
+	fs.publish(ChangeType_CHANGE_UPDATED, p)
+	return proto.Clone(p.page).(*PageObject), nil
+}
+
+func (fs *FakePartitionServiceServer) ListPageVersions(_ context.Context, req *PageVersionListRequest) (*PageVersionListResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	p, ok := fs.pages[req.GetPageId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "page", req.GetPageId(), "page %s not found", req.GetPageId())
+	}
+
+	response := &PageVersionListResponse{}
+	for i := len(p.versions) - 1; i >= 0; i-- {
+		response.Version = append(response.Version, proto.Clone(p.versions[i]).(*PageVersion))
+	}
+	return response, nil
+}
+
+// RollbackPage restores the html of a prior version as a new version, so the rollback can itself be undone.
+func (fs *FakePartitionServiceServer) RollbackPage(_ context.Context, req *PageRollbackRequest) (*PageObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	p, ok := fs.pages[req.GetPageId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "page", req.GetPageId(), "page %s not found", req.GetPageId())
+	}
+
+	if req.GetVersion() > uint32(len(p.versions)) {
+		return nil, resourceError(codes.NotFound, "page version", fmt.Sprintf("%s/%d", req.GetPageId(), req.GetVersion()),
+			"page %s has no version %d", req.GetPageId(), req.GetVersion())
+	}
+
+	p.addVersion(p.versions[req.GetVersion()-1].GetHtml())
+	p.page.ModifiedAt = timestamppb.Now()
+
+	fs.publish(ChangeType_CHANGE_UPDATED, p)
+	return proto.Clone(p.page).(*PageObject), nil
+}
+
+// RenderPage renders the closest active page of the name found on the partition or its ancestors,
+// the variables of template pages are always resolved for the requested partition.
+func (fs *FakePartitionServiceServer) RenderPage(_ context.Context, req *PageRenderRequest) (*PageRenderResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	partition, ok := fs.partitions[req.GetPartitionId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	chain := fs.partitionChain(partition)
+	for _, owner := range chain {
+		p := fs.findPage(owner.GetPartitionId(), req.GetName())
+		if p == nil || p.page.GetState() != common.STATE_ACTIVE {
+			continue
+		}
+
+		html := p.page.GetHtml()
+		if p.page.GetTemplate() {
+			rendered, err := RenderPageTemplate(html, chain)
+			if err != nil {
+				return nil, templateError(err)
+			}
+			html = rendered
+		}
+		return &PageRenderResponse{
+			Html:            html,
+			PageId:          p.page.GetPageId(),
+			PagePartitionId: owner.GetPartitionId(),
+			Version:         p.page.GetVersion(),
+		}, nil
+	}
+
+	return nil, resourceError(codes.NotFound, "page", req.GetName(),
+		"page %s not found on partition %s or its ancestors", req.GetName(), req.GetPartitionId())
+}
+
+// addVersion makes html the current version of the page. The caller must hold the lock.
+func (p *fakePage) addVersion(html string) {
+	p.page.Html = html
+	p.page.Version = uint32(len(p.versions)) + 1
+	p.versions = append(p.versions, &PageVersion{Version: p.page.GetVersion(), Html: html, CreatedAt: timestamppb.Now()})
+}
+
+// CreateAccess grants a profile access to a partition, a profile can only be granted access once per partition.
+func (fs *FakePartitionServiceServer) CreateAccess(ctx context.Context, req *AccessCreateRequest) (*AccessObject, error) {
+	return replayIdempotent(fs, ctx, "CreateAccess", req, fs.createAccess)
+}
+
+func (fs *FakePartitionServiceServer) createAccess(req *AccessCreateRequest) (*AccessObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	partition, ok := fs.partitions[req.GetPartitionId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	if access := fs.findAccess(req.GetPartitionId(), req.GetProfileId()); access != nil {
+		return nil, resourceError(codes.AlreadyExists, "access", access.GetAccessId(),
+			"profile %s already has access to partition %s", req.GetProfileId(), req.GetPartitionId())
+	}
+
+	now := timestamppb.Now()
+	access := &AccessObject{
+		AccessId:   newFakeId(),
+		ProfileId:  req.GetProfileId(),
+		Partition:  partition,
+		State:      common.STATE_ACTIVE,
+		CreatedAt:  now,
+		ModifiedAt: now,
+	}
+
+	fs.access[access.GetAccessId()] = access
+	fs.accessOrder = append(fs.accessOrder, access.GetAccessId())
+	fs.publish(ChangeType_CHANGE_CREATED, access)
+	return fs.accessView(access), nil
+}
+
+// GetAccess finds access either by its id or by the partition and profile it links.
+func (fs *FakePartitionServiceServer) GetAccess(_ context.Context, req *AccessGetRequest) (*AccessObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	if req.GetAccessId() != "" {
+		access, ok := fs.access[req.GetAccessId()]
+		if !ok {
+			return nil, resourceError(codes.NotFound, "access", req.GetAccessId(), "access %s not found", req.GetAccessId())
+		}
+		return fs.accessView(access), nil
+	}
+
+	if req.GetPartitionId() == "" || req.GetProfileId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "either an access id or a partition id and profile id are required")
+	}
+
+	for partitionId := req.GetPartitionId(); partitionId != ""; partitionId = fs.partitions[partitionId].GetParentId() {
+		if access := fs.findAccess(partitionId, req.GetProfileId()); access != nil {
+			view := fs.accessView(access)
+			view.Inherited = partitionId != req.GetPartitionId()
+			return view, nil
+		}
+		if !req.GetIncludeInherited() {
+			break
+		}
+	}
+	return nil, resourceError(codes.NotFound, "access", req.GetProfileId(),
+		"profile %s has no access to partition %s", req.GetProfileId(), req.GetPartitionId())
+}
+
+// ListAccessByProfile streams the access of a profile in the order it was granted, access that was removed
+// keeps its position so page tokens stay valid.
+func (fs *FakePartitionServiceServer) ListAccessByProfile(req *AccessListByProfileRequest, stream PartitionService_ListAccessByProfileServer) error {
+	if err := validateRequest(req); err != nil {
+		return err
+	}
+
+	fs.mu.RLock()
+	var matched []*AccessObject
+	var positions []int
+	for position, id := range fs.accessOrder {
+		access, ok := fs.access[id]
+		if !ok || access.GetProfileId() != req.GetProfileId() || !matchesStates(req.GetStates(), access.GetState()) {
+			continue
+		}
+
+		view := fs.accessView(access)
+		partition := view.GetPartition()
+		if req.GetTenantId() != "" && partition.GetTenantId() != req.GetTenantId() {
+			continue
+		}
+		if !matchesQuery(req.GetSearch().GetQuery(),
+			[]string{partition.GetPartitionId(), partition.GetName(), partition.GetDescription()}, partition.GetProperties()) {
+			continue
+		}
+
+		if req.GetIncludeRoles() {
+			view.Roles = fs.accessRolesOf(access.GetAccessId())
+		}
+		matched = append(matched, view)
+		positions = append(positions, position)
+	}
+	fs.mu.RUnlock()
+
+	start, end, nextPageToken, err := searchWindow(req.GetSearch(), positions)
+	if err != nil {
+		return err
+	}
+
+	for _, access := range matched[start:end] {
+		if err := stream.Send(access); err != nil {
+			return err
+		}
+	}
+
+	sendNextPageToken(stream, nextPageToken)
+	return nil
+}
+
+// ListPartitionAccess streams the access granted on a partition with its roles ordered by creation time.
+// Newest first listings page over creation positions counted down from the end so their tokens stay valid
+// while access is granted.
+func (fs *FakePartitionServiceServer) ListPartitionAccess(req *PartitionAccessListRequest, stream PartitionService_ListPartitionAccessServer) error {
+	if err := validateRequest(req); err != nil {
+		return err
+	}
+
+	fs.mu.RLock()
+	if _, ok := fs.partitions[req.GetPartitionId()]; !ok {
+		fs.mu.RUnlock()
+		return resourceError(codes.NotFound, "partition", req.GetPartitionId(), "partition %s not found", req.GetPartitionId())
+	}
+
+	var matched []*AccessObject
+	var positions []int
+	for position, id := range fs.accessOrder {
+		access, ok := fs.access[id]
+		if !ok || access.GetPartition().GetPartitionId() != req.GetPartitionId() ||
+			!matchesStates(req.GetStates(), access.GetState()) ||
+			!matchesQuery(req.GetSearch().GetQuery(), []string{access.GetProfileId()}, nil) {
+			continue
+		}
+
+		view := fs.accessView(access)
+		view.Roles = fs.accessRolesOf(access.GetAccessId())
+		if req.GetRole() != "" && !hasRoleNamed(view.GetRoles(), req.GetRole()) {
+			continue
+		}
+		matched = append(matched, view)
+		positions = append(positions, position)
+	}
+	fs.mu.RUnlock()
+
+	if req.GetSort() == AccessSort_ACCESS_SORT_CREATED_DESC {
+		for i, j := 0, len(matched)-1; i < j; i, j = i+1, j-1 {
+			matched[i], matched[j] = matched[j], matched[i]
+			positions[i], positions[j] = positions[j], positions[i]
+		}
+		for i := range positions {
+			positions[i] = math.MaxInt32 - positions[i]
+		}
+	}
+
+	start, end, nextPageToken, err := searchWindow(req.GetSearch(), positions)
+	if err != nil {
+		return err
+	}
+
+	for _, access := range matched[start:end] {
+		if err := stream.Send(access); err != nil {
+			return err
+		}
+	}
+
+	sendNextPageToken(stream, nextPageToken)
+	return nil
+}
+
+func hasRoleNamed(roles []*AccessRoleObject, name string) bool {
+	for _, accessRole := range roles {
+		if accessRole.GetRole().GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesStates reports whether state is one of states, any state matches when none are given.
+func matchesStates(states []common.STATE, state common.STATE) bool {
+	if len(states) == 0 {
+		return true
+	}
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveAccess revokes access together with all the roles it was granted.
+func (fs *FakePartitionServiceServer) RemoveAccess(_ context.Context, req *AccessRemoveRequest) (*RemoveResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	access, ok := fs.access[req.GetAccessId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "access", req.GetAccessId(), "access %s not found", req.GetAccessId())
+	}
+
+	for id, accessRole := range fs.accessRoles {
+		if accessRole.GetAccessId() == req.GetAccessId() {
+			delete(fs.accessRoles, id)
+			fs.publish(ChangeType_CHANGE_REMOVED, accessRole)
+		}
+	}
+	delete(fs.access, req.GetAccessId())
+	fs.publish(ChangeType_CHANGE_REMOVED, access)
+
+	return &RemoveResponse{Succeeded: true}, nil
+}
+
+// CreateAccessRole grants an access one of the roles defined on the partition it belongs to.
+func (fs *FakePartitionServiceServer) CreateAccessRole(ctx context.Context, req *AccessRoleCreateRequest) (*AccessRoleObject, error) {
+	return replayIdempotent(fs, ctx, "CreateAccessRole", req, fs.createAccessRole)
+}
+
+func (fs *FakePartitionServiceServer) createAccessRole(req *AccessRoleCreateRequest) (*AccessRoleObject, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	access, ok := fs.access[req.GetAccessId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "access", req.GetAccessId(), "access %s not found", req.GetAccessId())
+	}
+
+	role, ok := fs.partitionRoles[req.GetPartitionRoleId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "partition role", req.GetPartitionRoleId(), "partition role %s not found", req.GetPartitionRoleId())
+	}
+
+	if role.GetPartitionId() != access.GetPartition().GetPartitionId() {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"partition role %s does not belong to the partition of access %s", role.GetPartitionRoleId(), access.GetAccessId())
+	}
+
+	for _, accessRole := range fs.accessRoles {
+		if accessRole.GetAccessId() == req.GetAccessId() && accessRole.GetRole().GetPartitionRoleId() == req.GetPartitionRoleId() {
+			return nil, resourceError(codes.AlreadyExists, "access role", accessRole.GetAccessRoleId(),
+				"access %s already holds role %s", req.GetAccessId(), req.GetPartitionRoleId())
+		}
+	}
+
+	now := timestamppb.Now()
+	accessRole := &AccessRoleObject{
+		AccessRoleId: newFakeId(),
+		AccessId:     req.GetAccessId(),
+		Role:         role,
+		CreatedAt:    now,
+		ModifiedAt:   now,
+	}
+
+	fs.accessRoles[accessRole.GetAccessRoleId()] = accessRole
+	fs.publish(ChangeType_CHANGE_CREATED, accessRole)
+	return proto.Clone(accessRole).(*AccessRoleObject), nil
+}
+
+func (fs *FakePartitionServiceServer) ListAccessRoles(_ context.Context, req *AccessRoleListRequest) (*AccessRoleListResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	if _, ok := fs.access[req.GetAccessId()]; !ok {
+		return nil, resourceError(codes.NotFound, "access", req.GetAccessId(), "access %s not found", req.GetAccessId())
+	}
+
+	return &AccessRoleListResponse{Role: fs.accessRolesOf(req.GetAccessId())}, nil
+}
+
+// accessRolesOf returns copies of the roles granted with an access ordered by name. The caller must hold the lock.
+func (fs *FakePartitionServiceServer) accessRolesOf(accessId string) []*AccessRoleObject {
+	var roles []*AccessRoleObject
+	for _, accessRole := range fs.accessRoles {
+		if accessRole.GetAccessId() == accessId {
+			roles = append(roles, proto.Clone(accessRole).(*AccessRoleObject))
+		}
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].GetRole().GetName() < roles[j].GetRole().GetName()
+	})
+	return roles
+}
+
+func (fs *FakePartitionServiceServer) RemoveAccessRole(_ context.Context, req *AccessRoleRemoveRequest) (*RemoveResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	accessRole, ok := fs.accessRoles[req.GetAccessRoleId()]
+	if !ok {
+		return nil, resourceError(codes.NotFound, "access role", req.GetAccessRoleId(), "access role %s not found", req.GetAccessRoleId())
+	}
+
+	delete(fs.accessRoles, req.GetAccessRoleId())
+	fs.publish(ChangeType_CHANGE_REMOVED, accessRole)
+	return &RemoveResponse{Succeeded: true}, nil
+}
+
+// CheckAccess reports whether the profile holds the role, a partition that does not exist grants nothing.
+func (fs *FakePartitionServiceServer) CheckAccess(_ context.Context, req *AccessCheckRequest) (*AccessCheckResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	return fs.checkAccess(req), nil
+}
+
+func (fs *FakePartitionServiceServer) CheckAccessBatch(_ context.Context, req *AccessCheckBatchRequest) (*AccessCheckBatchResponse, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	response := &AccessCheckBatchResponse{}
+	for _, check := range req.GetChecks() {
+		response.Results = append(response.Results, fs.checkAccess(check))
+	}
+	return response, nil
+}
+
+// checkAccess looks for an active access granting the role on the partition, and on its ancestors
+// from the closest up when requested, where only inheritable roles count. The caller must hold the lock.
+func (fs *FakePartitionServiceServer) checkAccess(req *AccessCheckRequest) *AccessCheckResponse {
+	for partitionId := req.GetPartitionId(); partitionId != ""; {
+		partition, ok := fs.partitions[partitionId]
+		if !ok {
+			break
+		}
+
+		access := fs.findAccess(partitionId, req.GetProfileId())
+		if access != nil && access.GetState() == common.STATE_ACTIVE {
+			if req.GetRole() == "" {
+				return &AccessCheckResponse{Allowed: true, AccessId: access.GetAccessId(), PartitionId: partitionId}
+			}
+
+			for _, accessRole := range fs.accessRoles {
+				if accessRole.GetAccessId() != access.GetAccessId() || accessRole.GetRole().GetName() != req.GetRole() {
+					continue
+				}
+				if partitionId == req.GetPartitionId() || !accessRole.GetRole().GetNonInheritable() {
+					return &AccessCheckResponse{Allowed: true, AccessId: access.GetAccessId(),
+						AccessRoleId: accessRole.GetAccessRoleId(), PartitionId: partitionId}
+				}
+			}
+		}
+
+		if !req.GetIncludeAncestors() {
+			break
+		}
+		partitionId = partition.GetParentId()
+	}
+
+	return &AccessCheckResponse{}
+}
+
+// findAccess returns the access of a profile to a partition if any. The caller must hold the lock.
+func (fs *FakePartitionServiceServer) findAccess(partitionId string, profileId string) *AccessObject {
+	for _, access := range fs.access {
+		if access.GetPartition().GetPartitionId() == partitionId && access.GetProfileId() == profileId {
+			return access
+		}
+	}
+	return nil
+}
+
+// accessView returns a copy of the access embedding the current state of its partition.
+func (fs *FakePartitionServiceServer) accessView(access *AccessObject) *AccessObject {
+	view := proto.Clone(access).(*AccessObject)
+	if partition, ok := fs.partitions[access.GetPartition().GetPartitionId()]; ok {
+		view.Partition = proto.Clone(partition).(*PartitionObject)
+	}
+	return view
+}
@@ -0,0 +1,495 @@
+package partitionv1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apic "github.com/antinvestor/apis"
+	"github.com/antinvestor/apis/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newFakeClient(t *testing.T, opts ...apic.ClientOption) (*FakePartitionServiceServer, *PartitionClient) {
+	t.Helper()
+
+	fakeServer := NewFakePartitionServiceServer()
+	t.Cleanup(fakeServer.Stop)
+
+	partCl, err := NewFakePartitionsClient(context.Background(), fakeServer, opts...)
+	if err != nil {
+		t.Fatalf("could not connect to fake partition service : %v", err)
+	}
+	t.Cleanup(func() { _ = partCl.Close() })
+
+	return fakeServer, partCl
+}
+
+func TestFakePartitionHierarchy(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", map[string]string{"region": "east"})
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+	if tenant.GetName() != "bank" || tenant.GetState() != common.STATE_ACTIVE || tenant.GetCreatedAt() == nil {
+		t.Errorf("expected a named active tenant with a creation time, got %v", tenant)
+	}
+
+	bank, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	branch, err := partCl.NewChildPartition(ctx, tenant.GetTenantId(), bank.GetPartitionId(),
+		"branch", "a branch of the bank", nil)
+	if err != nil {
+		t.Fatalf("could not create child partition : %v", err)
+	}
+	if branch.GetParentId() != bank.GetPartitionId() {
+		t.Errorf("child partition parent is %s, expected %s", branch.GetParentId(), bank.GetPartitionId())
+	}
+
+	_, err = partCl.NewChildPartition(ctx, tenant.GetTenantId(), "missingparent", "orphan", "a partition without parent", nil)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected not found for missing parent, got %v", err)
+	}
+
+	partitions, err := partCl.ListPartitions(ctx, "branch", 0, 0)
+	if err != nil {
+		t.Fatalf("could not list partitions : %v", err)
+	}
+	if len(partitions) != 1 || partitions[0].GetPartitionId() != branch.GetPartitionId() {
+		t.Errorf("expected only the branch partition to match the query, got %v", partitions)
+	}
+}
+
+func TestFakePartitionValidation(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	_, err := partCl.NewTenant(ctx, "bk", "short", nil)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument for short tenant fields, got %v", err)
+	}
+
+	_, err = partCl.GetPartition(ctx, "x")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument for short partition id, got %v", err)
+	}
+}
+
+func TestFakePartitionAccess(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	partition, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	access, err := partCl.CreateAccess(ctx, partition.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not create access : %v", err)
+	}
+
+	_, err = partCl.CreateAccess(ctx, partition.GetPartitionId(), "profile1")
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected already exists for duplicate access, got %v", err)
+	}
+
+	found, err := partCl.GetAccess(ctx, partition.GetPartitionId(), "profile1")
+	if err != nil {
+		t.Fatalf("could not get access : %v", err)
+	}
+	if found.GetAccessId() != access.GetAccessId() {
+		t.Errorf("got access %s, expected %s", found.GetAccessId(), access.GetAccessId())
+	}
+
+	role, err := partCl.CreatePartitionRole(ctx, partition.GetPartitionId(), "admin", nil)
+	if err != nil {
+		t.Fatalf("could not create partition role : %v", err)
+	}
+
+	_, err = partCl.CreateAccessRole(ctx, access.GetAccessId(), role.GetPartitionRoleId())
+	if err != nil {
+		t.Fatalf("could not create access role : %v", err)
+	}
+
+	accessRoles, err := partCl.ListAccess(ctx, access.GetAccessId())
+	if err != nil {
+		t.Fatalf("could not list access roles : %v", err)
+	}
+	if len(accessRoles.GetRole()) != 1 {
+		t.Fatalf("expected one access role, got %d", len(accessRoles.GetRole()))
+	}
+
+	_, err = partCl.RemovePartitionRole(ctx, role.GetPartitionRoleId())
+	if err != nil {
+		t.Fatalf("could not remove partition role : %v", err)
+	}
+
+	accessRoles, err = partCl.ListAccess(ctx, access.GetAccessId())
+	if err != nil {
+		t.Fatalf("could not list access roles : %v", err)
+	}
+	if len(accessRoles.GetRole()) != 0 {
+		t.Errorf("expected access roles to be removed with their partition role, got %d", len(accessRoles.GetRole()))
+	}
+}
+
+func TestFakePartitionPages(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	partition, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	page, err := partCl.NewPage(ctx, partition.GetPartitionId(), "signup", "<p>signup</p>")
+	if err != nil {
+		t.Fatalf("could not create page : %v", err)
+	}
+
+	found, err := partCl.GetPage(ctx, partition.GetPartitionId(), "signup")
+	if err != nil {
+		t.Fatalf("could not get page : %v", err)
+	}
+	if found.GetPageId() != page.GetPageId() || found.GetHtml() != page.GetHtml() {
+		t.Errorf("got page %v, expected %v", found, page)
+	}
+
+	if _, err = partCl.NewPage(ctx, partition.GetPartitionId(), "login", "<p>login</p>"); err != nil {
+		t.Fatalf("could not create page : %v", err)
+	}
+
+	pages, err := partCl.ListPages(ctx, partition.GetPartitionId())
+	if err != nil {
+		t.Fatalf("could not list pages : %v", err)
+	}
+	if len(pages) != 2 || pages[0].GetName() != "login" || pages[1].GetName() != "signup" {
+		t.Errorf("expected the login and signup pages, got %v", pages)
+	}
+
+	_, err = partCl.UpdatePage(ctx, page.GetPageId(), "login", "<p>signup</p>")
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected renaming onto another page to fail, got %v", err)
+	}
+
+	updated, err := partCl.UpdatePage(ctx, page.GetPageId(), "signup", "<p>broken")
+	if err != nil {
+		t.Fatalf("could not update page : %v", err)
+	}
+	if updated.GetHtml() != "<p>broken" || updated.GetVersion() != 2 {
+		t.Errorf("expected the second version of the page, got %v", updated)
+	}
+
+	rolledBack, err := partCl.RollbackPage(ctx, page.GetPageId(), 1)
+	if err != nil {
+		t.Fatalf("could not roll back page : %v", err)
+	}
+	if rolledBack.GetHtml() != page.GetHtml() || rolledBack.GetVersion() != 3 {
+		t.Errorf("expected the first html as the third version, got %v", rolledBack)
+	}
+
+	versions, err := partCl.ListPageVersions(ctx, page.GetPageId())
+	if err != nil {
+		t.Fatalf("could not list page versions : %v", err)
+	}
+	if len(versions) != 3 || versions[0].GetVersion() != 3 || versions[1].GetHtml() != "<p>broken" {
+		t.Errorf("expected 3 versions from the newest, got %v", versions)
+	}
+
+	_, err = partCl.RollbackPage(ctx, page.GetPageId(), 4)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected rolling back to a missing version to fail, got %v", err)
+	}
+
+	if _, err = partCl.RemovePage(ctx, page.GetPageId()); err != nil {
+		t.Fatalf("could not remove page : %v", err)
+	}
+
+	_, err = partCl.GetPage(ctx, partition.GetPartitionId(), "signup")
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected the removed page to be gone, got %v", err)
+	}
+}
+
+func TestFakeRenderPage(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	bank, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition",
+		map[string]string{"color": "blue"})
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	branch, err := partCl.NewChildPartition(ctx, tenant.GetTenantId(), bank.GetPartitionId(),
+		"east branch", "a branch of the bank", nil)
+	if err != nil {
+		t.Fatalf("could not create child partition : %v", err)
+	}
+
+	_, err = partCl.NewTemplatePage(ctx, bank.GetPartitionId(), "signup", "<p>{{ unknown }}</p>")
+	if !errors.Is(err, ErrInvalidArgument) || len(FieldViolations(err)) != 1 || FieldViolations(err)[0].Field != "Html" {
+		t.Errorf("expected an invalid template to be rejected on its html, got %v", err)
+	}
+
+	page, err := partCl.NewTemplatePage(ctx, bank.GetPartitionId(), "signup",
+		`<h1>Join {{ name }} of {{ parent.name }}</h1><p style="color:{{ properties.color }}"></p>`)
+	if err != nil {
+		t.Fatalf("could not create page : %v", err)
+	}
+
+	rendered, err := partCl.RenderPage(ctx, branch.GetPartitionId(), "signup")
+	if err != nil {
+		t.Fatalf("could not render page : %v", err)
+	}
+	if rendered.GetHtml() != `<h1>Join east branch of bank</h1><p style="color:blue"></p>` {
+		t.Errorf("expected the bank page rendered for the branch, got %s", rendered.GetHtml())
+	}
+	if rendered.GetPageId() != page.GetPageId() || rendered.GetPagePartitionId() != bank.GetPartitionId() {
+		t.Errorf("expected the page of the bank to be rendered, got %v", rendered)
+	}
+
+	own, err := partCl.NewTemplatePage(ctx, branch.GetPartitionId(), "signup", "<h1>{{ name }} signup</h1>")
+	if err != nil {
+		t.Fatalf("could not create page : %v", err)
+	}
+
+	rendered, err = partCl.RenderPage(ctx, branch.GetPartitionId(), "signup")
+	if err != nil {
+		t.Fatalf("could not render page : %v", err)
+	}
+	if rendered.GetPageId() != own.GetPageId() || rendered.GetHtml() != "<h1>east branch signup</h1>" {
+		t.Errorf("expected the page of the branch to take precedence, got %v", rendered)
+	}
+
+	_, err = partCl.RenderPage(ctx, branch.GetPartitionId(), "login")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a page missing on the whole chain to be reported, got %v", err)
+	}
+}
+
+func TestFakeRenderLiteralBraces(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	bank, err := partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	static, err := partCl.NewPage(ctx, bank.GetPartitionId(), "signup", `<input v-model="email">{{ form.email }}`)
+	if err != nil {
+		t.Fatalf("could not create page : %v", err)
+	}
+	if _, err = partCl.UpdatePage(ctx, static.GetPageId(), "signup", `<p>{{ form.email }}</p>`); err != nil {
+		t.Fatalf("could not update page : %v", err)
+	}
+
+	rendered, err := partCl.RenderPage(ctx, bank.GetPartitionId(), "signup")
+	if err != nil {
+		t.Fatalf("could not render page : %v", err)
+	}
+	if rendered.GetHtml() != `<p>{{ form.email }}</p>` {
+		t.Errorf("expected a page not created as a template to be served as it is, got %s", rendered.GetHtml())
+	}
+
+	_, err = partCl.NewTemplatePage(ctx, bank.GetPartitionId(), "login", `<h1>{{ name }}</h1><p>{{{{ form.email }}</p>`)
+	if err != nil {
+		t.Fatalf("could not create page : %v", err)
+	}
+
+	rendered, err = partCl.RenderPage(ctx, bank.GetPartitionId(), "login")
+	if err != nil {
+		t.Fatalf("could not render page : %v", err)
+	}
+	if rendered.GetHtml() != `<h1>bank</h1><p>{{ form.email }}</p>` {
+		t.Errorf("expected escaped braces to render literally, got %s", rendered.GetHtml())
+	}
+}
+
+func TestFakeTenantLifecycle(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	updated, err := partCl.UpdateTenant(ctx, tenant.GetTenantId(), "bank two", "a renamed bank tenant",
+		map[string]string{"region": "west"})
+	if err != nil {
+		t.Fatalf("could not update tenant : %v", err)
+	}
+	if updated.GetName() != "bank two" || updated.GetDescription() != "a renamed bank tenant" ||
+		updated.GetProperties()["region"] != "west" {
+		t.Errorf("tenant was not updated, got %v", updated)
+	}
+	if !updated.GetModifiedAt().AsTime().After(tenant.GetCreatedAt().AsTime()) {
+		t.Errorf("expected the modification time to move past the creation time")
+	}
+
+	suspended, err := partCl.SuspendTenant(ctx, tenant.GetTenantId())
+	if err != nil {
+		t.Fatalf("could not suspend tenant : %v", err)
+	}
+	if suspended.GetState() != common.STATE_INACTIVE {
+		t.Errorf("expected a suspended tenant to be inactive, got %v", suspended.GetState())
+	}
+
+	_, err = partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected failed precondition creating a partition for a suspended tenant, got %v", err)
+	}
+
+	_, err = partCl.ReactivateTenant(ctx, tenant.GetTenantId())
+	if err != nil {
+		t.Fatalf("could not reactivate tenant : %v", err)
+	}
+
+	_, err = partCl.NewPartition(ctx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Errorf("could not create partition for a reactivated tenant : %v", err)
+	}
+}
+
+func TestFakeIdempotentCreates(t *testing.T) {
+	ctx := context.Background()
+	fakeServer, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	// Every run of a job attaches the same key again, as a key only applies to a single create.
+	keyCtx := func() context.Context { return WithIdempotencyKey(ctx, "create-bank-partition") }
+	first, err := partCl.NewPartition(keyCtx(), tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	repeated, err := partCl.NewPartition(keyCtx(), tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not repeat partition creation : %v", err)
+	}
+	if repeated.GetPartitionId() != first.GetPartitionId() {
+		t.Errorf("expected the repeated create to return partition %s, got %s", first.GetPartitionId(), repeated.GetPartitionId())
+	}
+
+	partitions, err := partCl.ListPartitions(ctx, "", 0, 0)
+	if err != nil {
+		t.Fatalf("could not list partitions : %v", err)
+	}
+	if len(partitions) != 1 {
+		t.Errorf("expected a single partition to be created, got %d", len(partitions))
+	}
+
+	_, err = partCl.NewPartition(keyCtx(), tenant.GetTenantId(), "branch", "a branch of the bank", nil)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected failed precondition reusing a key for a different partition, got %v", err)
+	}
+
+	fakeServer.idempotencyMu.Lock()
+	fakeServer.idempotencyRetention = 0
+	for _, stored := range fakeServer.idempotentResponses {
+		stored.expires = time.Now().Add(-time.Second)
+	}
+	fakeServer.idempotencyMu.Unlock()
+
+	expired, err := partCl.NewPartition(keyCtx(), tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition after the key expired : %v", err)
+	}
+	if expired.GetPartitionId() == first.GetPartitionId() {
+		t.Errorf("expected a new partition once the key retention passed")
+	}
+}
+
+func TestIdempotencyKeyAppliesToOneCreate(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	jobCtx := WithIdempotencyKey(ctx, "job-42")
+	bank, err := partCl.NewPartition(jobCtx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	branch, err := partCl.NewPartition(jobCtx, tenant.GetTenantId(), "branch", "a branch of the bank", nil)
+	if err != nil {
+		t.Fatalf("expected a second create on the job context not to reuse the key, got %v", err)
+	}
+	if branch.GetPartitionId() == bank.GetPartitionId() {
+		t.Errorf("expected the second create to make a new partition")
+	}
+
+	_, err = partCl.UpdatePartition(jobCtx, bank.GetPartitionId(), "bank", "the renamed bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not update partition on the job context : %v", err)
+	}
+}
+
+func TestIdempotencyKeyKeptByRejectedCreate(t *testing.T) {
+	ctx := context.Background()
+	_, partCl := newFakeClient(t)
+
+	tenant, err := partCl.NewTenant(ctx, "bank", "a bank tenant for tests", nil)
+	if err != nil {
+		t.Fatalf("could not create tenant : %v", err)
+	}
+
+	jobCtx := WithIdempotencyKey(ctx, "job-1")
+	_, err = partCl.NewPartition(jobCtx, tenant.GetTenantId(), "b", "the bank partition", nil)
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected the invalid name to be rejected by the client, got %v", err)
+	}
+
+	bank, err := partCl.NewPartition(jobCtx, tenant.GetTenantId(), "bank", "the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not create partition : %v", err)
+	}
+
+	replayed, err := partCl.NewPartition(WithIdempotencyKey(ctx, "job-1"), tenant.GetTenantId(), "bank",
+		"the bank partition", nil)
+	if err != nil {
+		t.Fatalf("could not replay partition create : %v", err)
+	}
+	if replayed.GetPartitionId() != bank.GetPartitionId() {
+		t.Errorf("expected the corrected create to have used the job key, got %s and %s",
+			bank.GetPartitionId(), replayed.GetPartitionId())
+	}
+}
@@ -0,0 +1,170 @@
+package partitionv1
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// fakeEventRetention is the number of change events the fake keeps for watches to resume from.
+	fakeEventRetention = 1000
+	// fakeWatchHeartbeat is how often a watch whose position moved without sending a change reports it.
+	fakeWatchHeartbeat = time.Second * 15
+)
+
+// publish records a change to object and wakes up the watches waiting for one. The caller must hold the lock.
+// Pages are published as their *fakePage so the partition they belong to is known.
+func (fs *FakePartitionServiceServer) publish(change ChangeType, object interface{}) {
+	fs.sequence++
+	event := &ChangeEvent{
+		Sequence:   fs.sequence,
+		Change:     change,
+		OccurredAt: timestamppb.Now(),
+	}
+
+	switch object := object.(type) {
+	case *TenantObject:
+		event.Resource = ResourceType_RESOURCE_TENANT
+		event.Id = object.GetTenantId()
+		event.TenantId = object.GetTenantId()
+		event.Object = &ChangeEvent_Tenant{Tenant: proto.Clone(object).(*TenantObject)}
+	case *PartitionObject:
+		event.Resource = ResourceType_RESOURCE_PARTITION
+		event.Id = object.GetPartitionId()
+		event.TenantId = object.GetTenantId()
+		event.PartitionId = object.GetPartitionId()
+		event.Object = &ChangeEvent_Partition{Partition: proto.Clone(object).(*PartitionObject)}
+	case *PartitionRoleObject:
+		event.Resource = ResourceType_RESOURCE_PARTITION_ROLE
+		event.Id = object.GetPartitionRoleId()
+		event.TenantId = fs.partitions[object.GetPartitionId()].GetTenantId()
+		event.PartitionId = object.GetPartitionId()
+		event.Object = &ChangeEvent_PartitionRole{PartitionRole: proto.Clone(object).(*PartitionRoleObject)}
+	case *fakePage:
+		event.Resource = ResourceType_RESOURCE_PAGE
+		event.Id = object.page.GetPageId()
+		event.TenantId = fs.partitions[object.partitionId].GetTenantId()
+		event.PartitionId = object.partitionId
+		event.Object = &ChangeEvent_Page{Page: proto.Clone(object.page).(*PageObject)}
+	case *AccessObject:
+		view := fs.accessView(object)
+		event.Resource = ResourceType_RESOURCE_ACCESS
+		event.Id = view.GetAccessId()
+		event.TenantId = view.GetPartition().GetTenantId()
+		event.PartitionId = view.GetPartition().GetPartitionId()
+		event.Object = &ChangeEvent_Access{Access: view}
+	case *AccessRoleObject:
+		partitionId := object.GetRole().GetPartitionId()
+		event.Resource = ResourceType_RESOURCE_ACCESS_ROLE
+		event.Id = object.GetAccessRoleId()
+		event.TenantId = fs.partitions[partitionId].GetTenantId()
+		event.PartitionId = partitionId
+		event.Object = &ChangeEvent_AccessRole{AccessRole: proto.Clone(object).(*AccessRoleObject)}
+	}
+
+	fs.events = append(fs.events, event)
+	if len(fs.events) > fakeEventRetention {
+		fs.events = fs.events[len(fs.events)-fakeEventRetention:]
+	}
+
+	close(fs.eventsChanged)
+	fs.eventsChanged = make(chan struct{})
+}
+
+// retainedAfter reports whether every event following sequence is still kept. The caller must hold the lock.
+func (fs *FakePartitionServiceServer) retainedAfter(sequence uint64) bool {
+	if sequence > fs.sequence {
+		return false
+	}
+	return len(fs.events) == 0 || fs.events[0].GetSequence() <= sequence+1
+}
+
+func watchMatches(req *WatchRequest, event *ChangeEvent) bool {
+	if req.GetPartitionId() != "" && req.GetPartitionId() != event.GetPartitionId() {
+		return false
+	}
+	if len(req.GetResources()) == 0 {
+		return true
+	}
+	for _, resource := range req.GetResources() {
+		if resource == event.GetResource() {
+			return true
+		}
+	}
+	return false
+}
+
+// heartbeat reports the position of a watch, every event up to sequence was sent or did not match.
+func heartbeat(sequence uint64) *ChangeEvent {
+	return &ChangeEvent{Sequence: sequence, Change: ChangeType_CHANGE_HEARTBEAT, OccurredAt: timestamppb.Now()}
+}
+
+// Watch streams the retained events following the requested sequence and then every new event
+// until the client goes away. A heartbeat with the starting position is sent first, and another
+// whenever the position moved past changes that did not match during a heartbeat interval.
+func (fs *FakePartitionServiceServer) Watch(req *WatchRequest, stream PartitionService_WatchServer) error {
+	if err := validateRequest(req); err != nil {
+		return err
+	}
+
+	fs.mu.RLock()
+	last := req.GetAfterSequence()
+	if last == 0 && !req.GetResume() {
+		last = fs.sequence
+	}
+	interval := fs.watchHeartbeat
+	fs.mu.RUnlock()
+
+	if err := stream.Send(heartbeat(last)); err != nil {
+		return err
+	}
+	reported := last
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fs.mu.RLock()
+		if !fs.retainedAfter(last) {
+			fs.mu.RUnlock()
+			return status.Errorf(codes.OutOfRange, "changes after sequence %d are no longer retained", last)
+		}
+
+		var pending []*ChangeEvent
+		for _, event := range fs.events {
+			if event.GetSequence() > last {
+				pending = append(pending, event)
+			}
+		}
+		changed := fs.eventsChanged
+		fs.mu.RUnlock()
+
+		for _, event := range pending {
+			last = event.GetSequence()
+			if !watchMatches(req, event) {
+				continue
+			}
+			if err := stream.Send(proto.Clone(event).(*ChangeEvent)); err != nil {
+				return err
+			}
+			reported = last
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-changed:
+		case <-ticker.C:
+			if last != reported {
+				if err := stream.Send(heartbeat(last)); err != nil {
+					return err
+				}
+				reported = last
+			}
+		}
+	}
+}
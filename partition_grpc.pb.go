@@ -20,11 +20,19 @@ const _ = grpc.SupportPackageIsVersion7
 type PartitionServiceClient interface {
 	// Get a tenant in the system matching the id
 	GetTenant(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*TenantObject, error)
-	// List all tenants in the system matching the query in some way
+	// List all tenants in the system matching the query in some way,
+	// the next page token is sent in the next-page-token trailer
 	ListTenant(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (PartitionService_ListTenantClient, error)
 	// Log a new tenant request
 	CreateTenant(ctx context.Context, in *TenantRequest, opts ...grpc.CallOption) (*TenantObject, error)
-	// List all tenants in the system matching the query in some way
+	// Update an existing tenant, its name, description, state and properties
+	UpdateTenant(ctx context.Context, in *TenantUpdateRequest, opts ...grpc.CallOption) (*TenantObject, error)
+	// Suspend a tenant, no new partitions can be created for a suspended tenant
+	SuspendTenant(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*TenantObject, error)
+	// Reactivate a suspended tenant
+	ReactivateTenant(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*TenantObject, error)
+	// List all partitions in the system matching the query in some way,
+	// the next page token is sent in the next-page-token trailer
 	ListPartition(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (PartitionService_ListPartitionClient, error)
 	// Log a new partition request
 	CreatePartition(ctx context.Context, in *PartitionCreateRequest, opts ...grpc.CallOption) (*PartitionObject, error)
@@ -32,6 +40,14 @@ type PartitionServiceClient interface {
 	GetPartition(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionObject, error)
 	// Update an existing partition object
 	UpdatePartition(ctx context.Context, in *PartitionUpdateRequest, opts ...grpc.CallOption) (*PartitionObject, error)
+	// Move a partition under a new parent or tenant keeping its access and roles
+	MovePartition(ctx context.Context, in *PartitionMoveRequest, opts ...grpc.CallOption) (*PartitionObject, error)
+	// List the partitions whose parent is the partition matching the id
+	ListChildPartitions(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (PartitionService_ListChildPartitionsClient, error)
+	// Get the parent chain of a partition ordered from its parent up to the root partition
+	GetPartitionAncestors(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionListResponse, error)
+	// Get a partition followed by its descendants breadth first, limited to the requested depth
+	GetPartitionSubtree(ctx context.Context, in *PartitionSubtreeRequest, opts ...grpc.CallOption) (PartitionService_GetPartitionSubtreeClient, error)
 	// Create a partition Role for a particular partition
 	CreatePartitionRole(ctx context.Context, in *PartitionRoleCreateRequest, opts ...grpc.CallOption) (*PartitionRoleObject, error)
 	// List partition roles available for this particular partition
@@ -44,10 +60,26 @@ type PartitionServiceClient interface {
 	GetPage(ctx context.Context, in *PageGetRequest, opts ...grpc.CallOption) (*PageObject, error)
 	// Removes a page from being accessible for a partition
 	RemovePage(ctx context.Context, in *PageRemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+	// List the pages of a partition ordered by name
+	ListPages(ctx context.Context, in *PageListRequest, opts ...grpc.CallOption) (*PageListResponse, error)
+	// Update the name, html or state of a page keeping the html it replaces as a prior version
+	UpdatePage(ctx context.Context, in *PageUpdateRequest, opts ...grpc.CallOption) (*PageObject, error)
+	// List the versions kept of the html of a page
+	ListPageVersions(ctx context.Context, in *PageVersionListRequest, opts ...grpc.CallOption) (*PageVersionListResponse, error)
+	// Restore the html of a prior version of a page
+	RollbackPage(ctx context.Context, in *PageRollbackRequest, opts ...grpc.CallOption) (*PageObject, error)
+	// Render the html of a page for a partition falling back to the pages of its ancestors
+	RenderPage(ctx context.Context, in *PageRenderRequest, opts ...grpc.CallOption) (*PageRenderResponse, error)
 	// Creates a users ability to access a partition
 	CreateAccess(ctx context.Context, in *AccessCreateRequest, opts ...grpc.CallOption) (*AccessObject, error)
 	// Obtains a users access to a partition by access id or partition and profile id
 	GetAccess(ctx context.Context, in *AccessGetRequest, opts ...grpc.CallOption) (*AccessObject, error)
+	// List the access a profile holds across partitions in the order it was granted,
+	// the next page token is sent in the next-page-token trailer
+	ListAccessByProfile(ctx context.Context, in *AccessListByProfileRequest, opts ...grpc.CallOption) (PartitionService_ListAccessByProfileClient, error)
+	// List the access granted on a partition with its roles ordered by creation time,
+	// the next page token is sent in the next-page-token trailer
+	ListPartitionAccess(ctx context.Context, in *PartitionAccessListRequest, opts ...grpc.CallOption) (PartitionService_ListPartitionAccessClient, error)
 	// Removes a user's ability to access a partition
 	RemoveAccess(ctx context.Context, in *AccessRemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
 	// Create an access Role for a particular access
@@ -56,6 +88,14 @@ type PartitionServiceClient interface {
 	ListAccessRoles(ctx context.Context, in *AccessRoleListRequest, opts ...grpc.CallOption) (*AccessRoleListResponse, error)
 	// Remove an access role that is not required
 	RemoveAccessRole(ctx context.Context, in *AccessRoleRemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+	// Check whether a profile holds a role in a partition
+	CheckAccess(ctx context.Context, in *AccessCheckRequest, opts ...grpc.CallOption) (*AccessCheckResponse, error)
+	// Check a batch of profile, partition and role combinations at once
+	CheckAccessBatch(ctx context.Context, in *AccessCheckBatchRequest, opts ...grpc.CallOption) (*AccessCheckBatchResponse, error)
+	// Stream the changes made to tenants, partitions, roles, pages and access in sequence order
+	// along with heartbeats reporting the position of the watch, fails with OUT_OF_RANGE when the
+	// changes after the requested sequence are no longer retained
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionService_WatchClient, error)
 }
 
 type partitionServiceClient struct {
@@ -116,6 +156,33 @@ func (c *partitionServiceClient) CreateTenant(ctx context.Context, in *TenantReq
 	return out, nil
 }
 
+func (c *partitionServiceClient) UpdateTenant(ctx context.Context, in *TenantUpdateRequest, opts ...grpc.CallOption) (*TenantObject, error) {
+	out := new(TenantObject)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/UpdateTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) SuspendTenant(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*TenantObject, error) {
+	out := new(TenantObject)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/SuspendTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) ReactivateTenant(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*TenantObject, error) {
+	out := new(TenantObject)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/ReactivateTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *partitionServiceClient) ListPartition(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (PartitionService_ListPartitionClient, error) {
 	stream, err := c.cc.NewStream(ctx, &PartitionService_ServiceDesc.Streams[1], "/partition.PartitionService/ListPartition", opts...)
 	if err != nil {
@@ -175,6 +242,88 @@ func (c *partitionServiceClient) UpdatePartition(ctx context.Context, in *Partit
 	return out, nil
 }
 
+func (c *partitionServiceClient) MovePartition(ctx context.Context, in *PartitionMoveRequest, opts ...grpc.CallOption) (*PartitionObject, error) {
+	out := new(PartitionObject)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/MovePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) ListChildPartitions(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (PartitionService_ListChildPartitionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PartitionService_ServiceDesc.Streams[2], "/partition.PartitionService/ListChildPartitions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partitionServiceListChildPartitionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PartitionService_ListChildPartitionsClient interface {
+	Recv() (*PartitionObject, error)
+	grpc.ClientStream
+}
+
+type partitionServiceListChildPartitionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *partitionServiceListChildPartitionsClient) Recv() (*PartitionObject, error) {
+	m := new(PartitionObject)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *partitionServiceClient) GetPartitionAncestors(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionListResponse, error) {
+	out := new(PartitionListResponse)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/GetPartitionAncestors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) GetPartitionSubtree(ctx context.Context, in *PartitionSubtreeRequest, opts ...grpc.CallOption) (PartitionService_GetPartitionSubtreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PartitionService_ServiceDesc.Streams[3], "/partition.PartitionService/GetPartitionSubtree", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partitionServiceGetPartitionSubtreeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PartitionService_GetPartitionSubtreeClient interface {
+	Recv() (*PartitionObject, error)
+	grpc.ClientStream
+}
+
+type partitionServiceGetPartitionSubtreeClient struct {
+	grpc.ClientStream
+}
+
+func (x *partitionServiceGetPartitionSubtreeClient) Recv() (*PartitionObject, error) {
+	m := new(PartitionObject)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *partitionServiceClient) CreatePartitionRole(ctx context.Context, in *PartitionRoleCreateRequest, opts ...grpc.CallOption) (*PartitionRoleObject, error) {
 	out := new(PartitionRoleObject)
 	err := c.cc.Invoke(ctx, "/partition.PartitionService/CreatePartitionRole", in, out, opts...)
@@ -229,6 +378,51 @@ func (c *partitionServiceClient) RemovePage(ctx context.Context, in *PageRemoveR
 	return out, nil
 }
 
+func (c *partitionServiceClient) ListPages(ctx context.Context, in *PageListRequest, opts ...grpc.CallOption) (*PageListResponse, error) {
+	out := new(PageListResponse)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/ListPages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) UpdatePage(ctx context.Context, in *PageUpdateRequest, opts ...grpc.CallOption) (*PageObject, error) {
+	out := new(PageObject)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/UpdatePage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) ListPageVersions(ctx context.Context, in *PageVersionListRequest, opts ...grpc.CallOption) (*PageVersionListResponse, error) {
+	out := new(PageVersionListResponse)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/ListPageVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) RollbackPage(ctx context.Context, in *PageRollbackRequest, opts ...grpc.CallOption) (*PageObject, error) {
+	out := new(PageObject)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/RollbackPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) RenderPage(ctx context.Context, in *PageRenderRequest, opts ...grpc.CallOption) (*PageRenderResponse, error) {
+	out := new(PageRenderResponse)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/RenderPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *partitionServiceClient) CreateAccess(ctx context.Context, in *AccessCreateRequest, opts ...grpc.CallOption) (*AccessObject, error) {
 	out := new(AccessObject)
 	err := c.cc.Invoke(ctx, "/partition.PartitionService/CreateAccess", in, out, opts...)
@@ -247,6 +441,70 @@ func (c *partitionServiceClient) GetAccess(ctx context.Context, in *AccessGetReq
 	return out, nil
 }
 
+func (c *partitionServiceClient) ListAccessByProfile(ctx context.Context, in *AccessListByProfileRequest, opts ...grpc.CallOption) (PartitionService_ListAccessByProfileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PartitionService_ServiceDesc.Streams[4], "/partition.PartitionService/ListAccessByProfile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partitionServiceListAccessByProfileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PartitionService_ListAccessByProfileClient interface {
+	Recv() (*AccessObject, error)
+	grpc.ClientStream
+}
+
+type partitionServiceListAccessByProfileClient struct {
+	grpc.ClientStream
+}
+
+func (x *partitionServiceListAccessByProfileClient) Recv() (*AccessObject, error) {
+	m := new(AccessObject)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *partitionServiceClient) ListPartitionAccess(ctx context.Context, in *PartitionAccessListRequest, opts ...grpc.CallOption) (PartitionService_ListPartitionAccessClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PartitionService_ServiceDesc.Streams[5], "/partition.PartitionService/ListPartitionAccess", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partitionServiceListPartitionAccessClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PartitionService_ListPartitionAccessClient interface {
+	Recv() (*AccessObject, error)
+	grpc.ClientStream
+}
+
+type partitionServiceListPartitionAccessClient struct {
+	grpc.ClientStream
+}
+
+func (x *partitionServiceListPartitionAccessClient) Recv() (*AccessObject, error) {
+	m := new(AccessObject)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *partitionServiceClient) RemoveAccess(ctx context.Context, in *AccessRemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
 	out := new(RemoveResponse)
 	err := c.cc.Invoke(ctx, "/partition.PartitionService/RemoveAccess", in, out, opts...)
@@ -283,17 +541,75 @@ func (c *partitionServiceClient) RemoveAccessRole(ctx context.Context, in *Acces
 	return out, nil
 }
 
+func (c *partitionServiceClient) CheckAccess(ctx context.Context, in *AccessCheckRequest, opts ...grpc.CallOption) (*AccessCheckResponse, error) {
+	out := new(AccessCheckResponse)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/CheckAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) CheckAccessBatch(ctx context.Context, in *AccessCheckBatchRequest, opts ...grpc.CallOption) (*AccessCheckBatchResponse, error) {
+	out := new(AccessCheckBatchResponse)
+	err := c.cc.Invoke(ctx, "/partition.PartitionService/CheckAccessBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PartitionService_ServiceDesc.Streams[6], "/partition.PartitionService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partitionServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PartitionService_WatchClient interface {
+	Recv() (*ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type partitionServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *partitionServiceWatchClient) Recv() (*ChangeEvent, error) {
+	m := new(ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PartitionServiceServer is the server API for PartitionService service.
 // All implementations must embed UnimplementedPartitionServiceServer
 // for forward compatibility
 type PartitionServiceServer interface {
 	// Get a tenant in the system matching the id
 	GetTenant(context.Context, *GetRequest) (*TenantObject, error)
-	// List all tenants in the system matching the query in some way
+	// List all tenants in the system matching the query in some way,
+	// the next page token is sent in the next-page-token trailer
 	ListTenant(*SearchRequest, PartitionService_ListTenantServer) error
 	// Log a new tenant request
 	CreateTenant(context.Context, *TenantRequest) (*TenantObject, error)
-	// List all tenants in the system matching the query in some way
+	// Update an existing tenant, its name, description, state and properties
+	UpdateTenant(context.Context, *TenantUpdateRequest) (*TenantObject, error)
+	// Suspend a tenant, no new partitions can be created for a suspended tenant
+	SuspendTenant(context.Context, *GetRequest) (*TenantObject, error)
+	// Reactivate a suspended tenant
+	ReactivateTenant(context.Context, *GetRequest) (*TenantObject, error)
+	// List all partitions in the system matching the query in some way,
+	// the next page token is sent in the next-page-token trailer
 	ListPartition(*SearchRequest, PartitionService_ListPartitionServer) error
 	// Log a new partition request
 	CreatePartition(context.Context, *PartitionCreateRequest) (*PartitionObject, error)
@@ -301,6 +617,14 @@ type PartitionServiceServer interface {
 	GetPartition(context.Context, *GetRequest) (*PartitionObject, error)
 	// Update an existing partition object
 	UpdatePartition(context.Context, *PartitionUpdateRequest) (*PartitionObject, error)
+	// Move a partition under a new parent or tenant keeping its access and roles
+	MovePartition(context.Context, *PartitionMoveRequest) (*PartitionObject, error)
+	// List the partitions whose parent is the partition matching the id
+	ListChildPartitions(*GetRequest, PartitionService_ListChildPartitionsServer) error
+	// Get the parent chain of a partition ordered from its parent up to the root partition
+	GetPartitionAncestors(context.Context, *GetRequest) (*PartitionListResponse, error)
+	// Get a partition followed by its descendants breadth first, limited to the requested depth
+	GetPartitionSubtree(*PartitionSubtreeRequest, PartitionService_GetPartitionSubtreeServer) error
 	// Create a partition Role for a particular partition
 	CreatePartitionRole(context.Context, *PartitionRoleCreateRequest) (*PartitionRoleObject, error)
 	// List partition roles available for this particular partition
@@ -313,10 +637,26 @@ type PartitionServiceServer interface {
 	GetPage(context.Context, *PageGetRequest) (*PageObject, error)
 	// Removes a page from being accessible for a partition
 	RemovePage(context.Context, *PageRemoveRequest) (*RemoveResponse, error)
+	// List the pages of a partition ordered by name
+	ListPages(context.Context, *PageListRequest) (*PageListResponse, error)
+	// Update the name, html or state of a page keeping the html it replaces as a prior version
+	UpdatePage(context.Context, *PageUpdateRequest) (*PageObject, error)
+	// List the versions kept of the html of a page
+	ListPageVersions(context.Context, *PageVersionListRequest) (*PageVersionListResponse, error)
+	// Restore the html of a prior version of a page
+	RollbackPage(context.Context, *PageRollbackRequest) (*PageObject, error)
+	// Render the html of a page for a partition falling back to the pages of its ancestors
+	RenderPage(context.Context, *PageRenderRequest) (*PageRenderResponse, error)
 	// Creates a users ability to access a partition
 	CreateAccess(context.Context, *AccessCreateRequest) (*AccessObject, error)
 	// Obtains a users access to a partition by access id or partition and profile id
 	GetAccess(context.Context, *AccessGetRequest) (*AccessObject, error)
+	// List the access a profile holds across partitions in the order it was granted,
+	// the next page token is sent in the next-page-token trailer
+	ListAccessByProfile(*AccessListByProfileRequest, PartitionService_ListAccessByProfileServer) error
+	// List the access granted on a partition with its roles ordered by creation time,
+	// the next page token is sent in the next-page-token trailer
+	ListPartitionAccess(*PartitionAccessListRequest, PartitionService_ListPartitionAccessServer) error
 	// Removes a user's ability to access a partition
 	RemoveAccess(context.Context, *AccessRemoveRequest) (*RemoveResponse, error)
 	// Create an access Role for a particular access
@@ -325,6 +665,14 @@ type PartitionServiceServer interface {
 	ListAccessRoles(context.Context, *AccessRoleListRequest) (*AccessRoleListResponse, error)
 	// Remove an access role that is not required
 	RemoveAccessRole(context.Context, *AccessRoleRemoveRequest) (*RemoveResponse, error)
+	// Check whether a profile holds a role in a partition
+	CheckAccess(context.Context, *AccessCheckRequest) (*AccessCheckResponse, error)
+	// Check a batch of profile, partition and role combinations at once
+	CheckAccessBatch(context.Context, *AccessCheckBatchRequest) (*AccessCheckBatchResponse, error)
+	// Stream the changes made to tenants, partitions, roles, pages and access in sequence order
+	// along with heartbeats reporting the position of the watch, fails with OUT_OF_RANGE when the
+	// changes after the requested sequence are no longer retained
+	Watch(*WatchRequest, PartitionService_WatchServer) error
 	mustEmbedUnimplementedPartitionServiceServer()
 }
 
@@ -341,6 +689,15 @@ func (UnimplementedPartitionServiceServer) ListTenant(*SearchRequest, PartitionS
 func (UnimplementedPartitionServiceServer) CreateTenant(context.Context, *TenantRequest) (*TenantObject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
 }
+func (UnimplementedPartitionServiceServer) UpdateTenant(context.Context, *TenantUpdateRequest) (*TenantObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedPartitionServiceServer) SuspendTenant(context.Context, *GetRequest) (*TenantObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendTenant not implemented")
+}
+func (UnimplementedPartitionServiceServer) ReactivateTenant(context.Context, *GetRequest) (*TenantObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateTenant not implemented")
+}
 func (UnimplementedPartitionServiceServer) ListPartition(*SearchRequest, PartitionService_ListPartitionServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListPartition not implemented")
 }
@@ -353,6 +710,18 @@ func (UnimplementedPartitionServiceServer) GetPartition(context.Context, *GetReq
 func (UnimplementedPartitionServiceServer) UpdatePartition(context.Context, *PartitionUpdateRequest) (*PartitionObject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePartition not implemented")
 }
+func (UnimplementedPartitionServiceServer) MovePartition(context.Context, *PartitionMoveRequest) (*PartitionObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePartition not implemented")
+}
+func (UnimplementedPartitionServiceServer) ListChildPartitions(*GetRequest, PartitionService_ListChildPartitionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListChildPartitions not implemented")
+}
+func (UnimplementedPartitionServiceServer) GetPartitionAncestors(context.Context, *GetRequest) (*PartitionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionAncestors not implemented")
+}
+func (UnimplementedPartitionServiceServer) GetPartitionSubtree(*PartitionSubtreeRequest, PartitionService_GetPartitionSubtreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPartitionSubtree not implemented")
+}
 func (UnimplementedPartitionServiceServer) CreatePartitionRole(context.Context, *PartitionRoleCreateRequest) (*PartitionRoleObject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartitionRole not implemented")
 }
@@ -371,12 +740,33 @@ func (UnimplementedPartitionServiceServer) GetPage(context.Context, *PageGetRequ
 func (UnimplementedPartitionServiceServer) RemovePage(context.Context, *PageRemoveRequest) (*RemoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemovePage not implemented")
 }
+func (UnimplementedPartitionServiceServer) ListPages(context.Context, *PageListRequest) (*PageListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPages not implemented")
+}
+func (UnimplementedPartitionServiceServer) UpdatePage(context.Context, *PageUpdateRequest) (*PageObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePage not implemented")
+}
+func (UnimplementedPartitionServiceServer) ListPageVersions(context.Context, *PageVersionListRequest) (*PageVersionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPageVersions not implemented")
+}
+func (UnimplementedPartitionServiceServer) RollbackPage(context.Context, *PageRollbackRequest) (*PageObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPage not implemented")
+}
+func (UnimplementedPartitionServiceServer) RenderPage(context.Context, *PageRenderRequest) (*PageRenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderPage not implemented")
+}
 func (UnimplementedPartitionServiceServer) CreateAccess(context.Context, *AccessCreateRequest) (*AccessObject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAccess not implemented")
 }
 func (UnimplementedPartitionServiceServer) GetAccess(context.Context, *AccessGetRequest) (*AccessObject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccess not implemented")
 }
+func (UnimplementedPartitionServiceServer) ListAccessByProfile(*AccessListByProfileRequest, PartitionService_ListAccessByProfileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAccessByProfile not implemented")
+}
+func (UnimplementedPartitionServiceServer) ListPartitionAccess(*PartitionAccessListRequest, PartitionService_ListPartitionAccessServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPartitionAccess not implemented")
+}
 func (UnimplementedPartitionServiceServer) RemoveAccess(context.Context, *AccessRemoveRequest) (*RemoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccess not implemented")
 }
@@ -389,6 +779,15 @@ func (UnimplementedPartitionServiceServer) ListAccessRoles(context.Context, *Acc
 func (UnimplementedPartitionServiceServer) RemoveAccessRole(context.Context, *AccessRoleRemoveRequest) (*RemoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccessRole not implemented")
 }
+func (UnimplementedPartitionServiceServer) CheckAccess(context.Context, *AccessCheckRequest) (*AccessCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+func (UnimplementedPartitionServiceServer) CheckAccessBatch(context.Context, *AccessCheckBatchRequest) (*AccessCheckBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccessBatch not implemented")
+}
+func (UnimplementedPartitionServiceServer) Watch(*WatchRequest, PartitionService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedPartitionServiceServer) mustEmbedUnimplementedPartitionServiceServer() {}
 
 // UnsafePartitionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -459,6 +858,60 @@ func _PartitionService_CreateTenant_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PartitionService_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/UpdateTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).UpdateTenant(ctx, req.(*TenantUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_SuspendTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).SuspendTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/SuspendTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).SuspendTenant(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_ReactivateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).ReactivateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/ReactivateTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).ReactivateTenant(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PartitionService_ListPartition_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SearchRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -534,6 +987,84 @@ func _PartitionService_UpdatePartition_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PartitionService_MovePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartitionMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).MovePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/MovePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).MovePartition(ctx, req.(*PartitionMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_ListChildPartitions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PartitionServiceServer).ListChildPartitions(m, &partitionServiceListChildPartitionsServer{stream})
+}
+
+type PartitionService_ListChildPartitionsServer interface {
+	Send(*PartitionObject) error
+	grpc.ServerStream
+}
+
+type partitionServiceListChildPartitionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *partitionServiceListChildPartitionsServer) Send(m *PartitionObject) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PartitionService_GetPartitionAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).GetPartitionAncestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/GetPartitionAncestors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).GetPartitionAncestors(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_GetPartitionSubtree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PartitionSubtreeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PartitionServiceServer).GetPartitionSubtree(m, &partitionServiceGetPartitionSubtreeServer{stream})
+}
+
+type PartitionService_GetPartitionSubtreeServer interface {
+	Send(*PartitionObject) error
+	grpc.ServerStream
+}
+
+type partitionServiceGetPartitionSubtreeServer struct {
+	grpc.ServerStream
+}
+
+func (x *partitionServiceGetPartitionSubtreeServer) Send(m *PartitionObject) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PartitionService_CreatePartitionRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PartitionRoleCreateRequest)
 	if err := dec(in); err != nil {
@@ -642,6 +1173,96 @@ func _PartitionService_RemovePage_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PartitionService_ListPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).ListPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/ListPages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).ListPages(ctx, req.(*PageListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_UpdatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).UpdatePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/UpdatePage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).UpdatePage(ctx, req.(*PageUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_ListPageVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageVersionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).ListPageVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/ListPageVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).ListPageVersions(ctx, req.(*PageVersionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_RollbackPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).RollbackPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/RollbackPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).RollbackPage(ctx, req.(*PageRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_RenderPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageRenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).RenderPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/RenderPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).RenderPage(ctx, req.(*PageRenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PartitionService_CreateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccessCreateRequest)
 	if err := dec(in); err != nil {
@@ -678,6 +1299,48 @@ func _PartitionService_GetAccess_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PartitionService_ListAccessByProfile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccessListByProfileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PartitionServiceServer).ListAccessByProfile(m, &partitionServiceListAccessByProfileServer{stream})
+}
+
+type PartitionService_ListAccessByProfileServer interface {
+	Send(*AccessObject) error
+	grpc.ServerStream
+}
+
+type partitionServiceListAccessByProfileServer struct {
+	grpc.ServerStream
+}
+
+func (x *partitionServiceListAccessByProfileServer) Send(m *AccessObject) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PartitionService_ListPartitionAccess_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PartitionAccessListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PartitionServiceServer).ListPartitionAccess(m, &partitionServiceListPartitionAccessServer{stream})
+}
+
+type PartitionService_ListPartitionAccessServer interface {
+	Send(*AccessObject) error
+	grpc.ServerStream
+}
+
+type partitionServiceListPartitionAccessServer struct {
+	grpc.ServerStream
+}
+
+func (x *partitionServiceListPartitionAccessServer) Send(m *AccessObject) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PartitionService_RemoveAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccessRemoveRequest)
 	if err := dec(in); err != nil {
@@ -750,6 +1413,63 @@ func _PartitionService_RemoveAccessRole_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PartitionService_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/CheckAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).CheckAccess(ctx, req.(*AccessCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_CheckAccessBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessCheckBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionServiceServer).CheckAccessBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partition.PartitionService/CheckAccessBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionServiceServer).CheckAccessBatch(ctx, req.(*AccessCheckBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PartitionServiceServer).Watch(m, &partitionServiceWatchServer{stream})
+}
+
+type PartitionService_WatchServer interface {
+	Send(*ChangeEvent) error
+	grpc.ServerStream
+}
+
+type partitionServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *partitionServiceWatchServer) Send(m *ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // PartitionService_ServiceDesc is the grpc.ServiceDesc for PartitionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -765,6 +1485,18 @@ var PartitionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTenant",
 			Handler:    _PartitionService_CreateTenant_Handler,
 		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _PartitionService_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "SuspendTenant",
+			Handler:    _PartitionService_SuspendTenant_Handler,
+		},
+		{
+			MethodName: "ReactivateTenant",
+			Handler:    _PartitionService_ReactivateTenant_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _PartitionService_CreatePartition_Handler,
@@ -777,6 +1509,14 @@ var PartitionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePartition",
 			Handler:    _PartitionService_UpdatePartition_Handler,
 		},
+		{
+			MethodName: "MovePartition",
+			Handler:    _PartitionService_MovePartition_Handler,
+		},
+		{
+			MethodName: "GetPartitionAncestors",
+			Handler:    _PartitionService_GetPartitionAncestors_Handler,
+		},
 		{
 			MethodName: "CreatePartitionRole",
 			Handler:    _PartitionService_CreatePartitionRole_Handler,
@@ -801,6 +1541,26 @@ var PartitionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemovePage",
 			Handler:    _PartitionService_RemovePage_Handler,
 		},
+		{
+			MethodName: "ListPages",
+			Handler:    _PartitionService_ListPages_Handler,
+		},
+		{
+			MethodName: "UpdatePage",
+			Handler:    _PartitionService_UpdatePage_Handler,
+		},
+		{
+			MethodName: "ListPageVersions",
+			Handler:    _PartitionService_ListPageVersions_Handler,
+		},
+		{
+			MethodName: "RollbackPage",
+			Handler:    _PartitionService_RollbackPage_Handler,
+		},
+		{
+			MethodName: "RenderPage",
+			Handler:    _PartitionService_RenderPage_Handler,
+		},
 		{
 			MethodName: "CreateAccess",
 			Handler:    _PartitionService_CreateAccess_Handler,
@@ -825,6 +1585,14 @@ var PartitionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveAccessRole",
 			Handler:    _PartitionService_RemoveAccessRole_Handler,
 		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _PartitionService_CheckAccess_Handler,
+		},
+		{
+			MethodName: "CheckAccessBatch",
+			Handler:    _PartitionService_CheckAccessBatch_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -837,6 +1605,31 @@ var PartitionService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _PartitionService_ListPartition_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListChildPartitions",
+			Handler:       _PartitionService_ListChildPartitions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetPartitionSubtree",
+			Handler:       _PartitionService_GetPartitionSubtree_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListAccessByProfile",
+			Handler:       _PartitionService_ListAccessByProfile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListPartitionAccess",
+			Handler:       _PartitionService_ListPartitionAccess_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _PartitionService_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "partition.proto",
 }
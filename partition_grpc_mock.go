@@ -36,6 +36,46 @@ func (m *MockPartitionServiceClient) EXPECT() *MockPartitionServiceClientMockRec
 	return m.recorder
 }
 
+// CheckAccess mocks base method.
+func (m *MockPartitionServiceClient) CheckAccess(ctx context.Context, in *AccessCheckRequest, opts ...grpc.CallOption) (*AccessCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckAccess", varargs...)
+	ret0, _ := ret[0].(*AccessCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockPartitionServiceClientMockRecorder) CheckAccess(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockPartitionServiceClient)(nil).CheckAccess), varargs...)
+}
+
+// CheckAccessBatch mocks base method.
+func (m *MockPartitionServiceClient) CheckAccessBatch(ctx context.Context, in *AccessCheckBatchRequest, opts ...grpc.CallOption) (*AccessCheckBatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckAccessBatch", varargs...)
+	ret0, _ := ret[0].(*AccessCheckBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAccessBatch indicates an expected call of CheckAccessBatch.
+func (mr *MockPartitionServiceClientMockRecorder) CheckAccessBatch(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccessBatch", reflect.TypeOf((*MockPartitionServiceClient)(nil).CheckAccessBatch), varargs...)
+}
+
 // CreateAccess mocks base method.
 func (m *MockPartitionServiceClient) CreateAccess(ctx context.Context, in *AccessCreateRequest, opts ...grpc.CallOption) (*AccessObject, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +256,46 @@ func (mr *MockPartitionServiceClientMockRecorder) GetPartition(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartition", reflect.TypeOf((*MockPartitionServiceClient)(nil).GetPartition), varargs...)
 }
 
+// GetPartitionAncestors mocks base method.
+func (m *MockPartitionServiceClient) GetPartitionAncestors(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PartitionListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPartitionAncestors", varargs...)
+	ret0, _ := ret[0].(*PartitionListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPartitionAncestors indicates an expected call of GetPartitionAncestors.
+func (mr *MockPartitionServiceClientMockRecorder) GetPartitionAncestors(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartitionAncestors", reflect.TypeOf((*MockPartitionServiceClient)(nil).GetPartitionAncestors), varargs...)
+}
+
+// GetPartitionSubtree mocks base method.
+func (m *MockPartitionServiceClient) GetPartitionSubtree(ctx context.Context, in *PartitionSubtreeRequest, opts ...grpc.CallOption) (PartitionService_GetPartitionSubtreeClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPartitionSubtree", varargs...)
+	ret0, _ := ret[0].(PartitionService_GetPartitionSubtreeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPartitionSubtree indicates an expected call of GetPartitionSubtree.
+func (mr *MockPartitionServiceClientMockRecorder) GetPartitionSubtree(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartitionSubtree", reflect.TypeOf((*MockPartitionServiceClient)(nil).GetPartitionSubtree), varargs...)
+}
+
 // GetTenant mocks base method.
 func (m *MockPartitionServiceClient) GetTenant(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*TenantObject, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +316,26 @@ func (mr *MockPartitionServiceClientMockRecorder) GetTenant(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenant", reflect.TypeOf((*MockPartitionServiceClient)(nil).GetTenant), varargs...)
 }
 
+// ListAccessByProfile mocks base method.
+func (m *MockPartitionServiceClient) ListAccessByProfile(ctx context.Context, in *AccessListByProfileRequest, opts ...grpc.CallOption) (PartitionService_ListAccessByProfileClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccessByProfile", varargs...)
+	ret0, _ := ret[0].(PartitionService_ListAccessByProfileClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccessByProfile indicates an expected call of ListAccessByProfile.
+func (mr *MockPartitionServiceClientMockRecorder) ListAccessByProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessByProfile", reflect.TypeOf((*MockPartitionServiceClient)(nil).ListAccessByProfile), varargs...)
+}
+
 // ListAccessRoles mocks base method.
 func (m *MockPartitionServiceClient) ListAccessRoles(ctx context.Context, in *AccessRoleListRequest, opts ...grpc.CallOption) (*AccessRoleListResponse, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +356,66 @@ func (mr *MockPartitionServiceClientMockRecorder) ListAccessRoles(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessRoles", reflect.TypeOf((*MockPartitionServiceClient)(nil).ListAccessRoles), varargs...)
 }
 
+// ListChildPartitions mocks base method.
+func (m *MockPartitionServiceClient) ListChildPartitions(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (PartitionService_ListChildPartitionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListChildPartitions", varargs...)
+	ret0, _ := ret[0].(PartitionService_ListChildPartitionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChildPartitions indicates an expected call of ListChildPartitions.
+func (mr *MockPartitionServiceClientMockRecorder) ListChildPartitions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChildPartitions", reflect.TypeOf((*MockPartitionServiceClient)(nil).ListChildPartitions), varargs...)
+}
+
+// ListPageVersions mocks base method.
+func (m *MockPartitionServiceClient) ListPageVersions(ctx context.Context, in *PageVersionListRequest, opts ...grpc.CallOption) (*PageVersionListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPageVersions", varargs...)
+	ret0, _ := ret[0].(*PageVersionListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPageVersions indicates an expected call of ListPageVersions.
+func (mr *MockPartitionServiceClientMockRecorder) ListPageVersions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPageVersions", reflect.TypeOf((*MockPartitionServiceClient)(nil).ListPageVersions), varargs...)
+}
+
+// ListPages mocks base method.
+func (m *MockPartitionServiceClient) ListPages(ctx context.Context, in *PageListRequest, opts ...grpc.CallOption) (*PageListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPages", varargs...)
+	ret0, _ := ret[0].(*PageListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPages indicates an expected call of ListPages.
+func (mr *MockPartitionServiceClientMockRecorder) ListPages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPages", reflect.TypeOf((*MockPartitionServiceClient)(nil).ListPages), varargs...)
+}
+
 // ListPartition mocks base method.
 func (m *MockPartitionServiceClient) ListPartition(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (PartitionService_ListPartitionClient, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +436,26 @@ func (mr *MockPartitionServiceClientMockRecorder) ListPartition(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartition", reflect.TypeOf((*MockPartitionServiceClient)(nil).ListPartition), varargs...)
 }
 
+// ListPartitionAccess mocks base method.
+func (m *MockPartitionServiceClient) ListPartitionAccess(ctx context.Context, in *PartitionAccessListRequest, opts ...grpc.CallOption) (PartitionService_ListPartitionAccessClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPartitionAccess", varargs...)
+	ret0, _ := ret[0].(PartitionService_ListPartitionAccessClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPartitionAccess indicates an expected call of ListPartitionAccess.
+func (mr *MockPartitionServiceClientMockRecorder) ListPartitionAccess(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartitionAccess", reflect.TypeOf((*MockPartitionServiceClient)(nil).ListPartitionAccess), varargs...)
+}
+
 // ListPartitionRoles mocks base method.
 func (m *MockPartitionServiceClient) ListPartitionRoles(ctx context.Context, in *PartitionRoleListRequest, opts ...grpc.CallOption) (*PartitionRoleListResponse, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +496,46 @@ func (mr *MockPartitionServiceClientMockRecorder) ListTenant(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenant", reflect.TypeOf((*MockPartitionServiceClient)(nil).ListTenant), varargs...)
 }
 
+// MovePartition mocks base method.
+func (m *MockPartitionServiceClient) MovePartition(ctx context.Context, in *PartitionMoveRequest, opts ...grpc.CallOption) (*PartitionObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MovePartition", varargs...)
+	ret0, _ := ret[0].(*PartitionObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePartition indicates an expected call of MovePartition.
+func (mr *MockPartitionServiceClientMockRecorder) MovePartition(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePartition", reflect.TypeOf((*MockPartitionServiceClient)(nil).MovePartition), varargs...)
+}
+
+// ReactivateTenant mocks base method.
+func (m *MockPartitionServiceClient) ReactivateTenant(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*TenantObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReactivateTenant", varargs...)
+	ret0, _ := ret[0].(*TenantObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReactivateTenant indicates an expected call of ReactivateTenant.
+func (mr *MockPartitionServiceClientMockRecorder) ReactivateTenant(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateTenant", reflect.TypeOf((*MockPartitionServiceClient)(nil).ReactivateTenant), varargs...)
+}
+
 // RemoveAccess mocks base method.
 func (m *MockPartitionServiceClient) RemoveAccess(ctx context.Context, in *AccessRemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
 	m.ctrl.T.Helper()
@@ -396,6 +616,86 @@ func (mr *MockPartitionServiceClientMockRecorder) RemovePartitionRole(ctx, in in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePartitionRole", reflect.TypeOf((*MockPartitionServiceClient)(nil).RemovePartitionRole), varargs...)
 }
 
+// RenderPage mocks base method.
+func (m *MockPartitionServiceClient) RenderPage(ctx context.Context, in *PageRenderRequest, opts ...grpc.CallOption) (*PageRenderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenderPage", varargs...)
+	ret0, _ := ret[0].(*PageRenderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderPage indicates an expected call of RenderPage.
+func (mr *MockPartitionServiceClientMockRecorder) RenderPage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderPage", reflect.TypeOf((*MockPartitionServiceClient)(nil).RenderPage), varargs...)
+}
+
+// RollbackPage mocks base method.
+func (m *MockPartitionServiceClient) RollbackPage(ctx context.Context, in *PageRollbackRequest, opts ...grpc.CallOption) (*PageObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackPage", varargs...)
+	ret0, _ := ret[0].(*PageObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackPage indicates an expected call of RollbackPage.
+func (mr *MockPartitionServiceClientMockRecorder) RollbackPage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackPage", reflect.TypeOf((*MockPartitionServiceClient)(nil).RollbackPage), varargs...)
+}
+
+// SuspendTenant mocks base method.
+func (m *MockPartitionServiceClient) SuspendTenant(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*TenantObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendTenant", varargs...)
+	ret0, _ := ret[0].(*TenantObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendTenant indicates an expected call of SuspendTenant.
+func (mr *MockPartitionServiceClientMockRecorder) SuspendTenant(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendTenant", reflect.TypeOf((*MockPartitionServiceClient)(nil).SuspendTenant), varargs...)
+}
+
+// UpdatePage mocks base method.
+func (m *MockPartitionServiceClient) UpdatePage(ctx context.Context, in *PageUpdateRequest, opts ...grpc.CallOption) (*PageObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePage", varargs...)
+	ret0, _ := ret[0].(*PageObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePage indicates an expected call of UpdatePage.
+func (mr *MockPartitionServiceClientMockRecorder) UpdatePage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePage", reflect.TypeOf((*MockPartitionServiceClient)(nil).UpdatePage), varargs...)
+}
+
 // UpdatePartition mocks base method.
 func (m *MockPartitionServiceClient) UpdatePartition(ctx context.Context, in *PartitionUpdateRequest, opts ...grpc.CallOption) (*PartitionObject, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +716,46 @@ func (mr *MockPartitionServiceClientMockRecorder) UpdatePartition(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePartition", reflect.TypeOf((*MockPartitionServiceClient)(nil).UpdatePartition), varargs...)
 }
 
+// UpdateTenant mocks base method.
+func (m *MockPartitionServiceClient) UpdateTenant(ctx context.Context, in *TenantUpdateRequest, opts ...grpc.CallOption) (*TenantObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTenant", varargs...)
+	ret0, _ := ret[0].(*TenantObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTenant indicates an expected call of UpdateTenant.
+func (mr *MockPartitionServiceClientMockRecorder) UpdateTenant(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockPartitionServiceClient)(nil).UpdateTenant), varargs...)
+}
+
+// Watch mocks base method.
+func (m *MockPartitionServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionService_WatchClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Watch", varargs...)
+	ret0, _ := ret[0].(PartitionService_WatchClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockPartitionServiceClientMockRecorder) Watch(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockPartitionServiceClient)(nil).Watch), varargs...)
+}
+
 // MockPartitionService_ListTenantClient is a mock of PartitionService_ListTenantClient interface.
 type MockPartitionService_ListTenantClient struct {
 	ctrl     *gomock.Controller
@@ -662,384 +1002,1844 @@ func (mr *MockPartitionService_ListPartitionClientMockRecorder) Trailer() *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockPartitionService_ListPartitionClient)(nil).Trailer))
 }
 
-// MockPartitionServiceServer is a mock of PartitionServiceServer interface.
-type MockPartitionServiceServer struct {
+// MockPartitionService_ListChildPartitionsClient is a mock of PartitionService_ListChildPartitionsClient interface.
+type MockPartitionService_ListChildPartitionsClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockPartitionServiceServerMockRecorder
+	recorder *MockPartitionService_ListChildPartitionsClientMockRecorder
 }
 
-// MockPartitionServiceServerMockRecorder is the mock recorder for MockPartitionServiceServer.
-type MockPartitionServiceServerMockRecorder struct {
-	mock *MockPartitionServiceServer
+// MockPartitionService_ListChildPartitionsClientMockRecorder is the mock recorder for MockPartitionService_ListChildPartitionsClient.
+type MockPartitionService_ListChildPartitionsClientMockRecorder struct {
+	mock *MockPartitionService_ListChildPartitionsClient
 }
 
-// NewMockPartitionServiceServer creates a new mock instance.
-func NewMockPartitionServiceServer(ctrl *gomock.Controller) *MockPartitionServiceServer {
-	mock := &MockPartitionServiceServer{ctrl: ctrl}
-	mock.recorder = &MockPartitionServiceServerMockRecorder{mock}
+// NewMockPartitionService_ListChildPartitionsClient creates a new mock instance.
+func NewMockPartitionService_ListChildPartitionsClient(ctrl *gomock.Controller) *MockPartitionService_ListChildPartitionsClient {
+	mock := &MockPartitionService_ListChildPartitionsClient{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_ListChildPartitionsClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockPartitionServiceServer) EXPECT() *MockPartitionServiceServerMockRecorder {
+func (m *MockPartitionService_ListChildPartitionsClient) EXPECT() *MockPartitionService_ListChildPartitionsClientMockRecorder {
 	return m.recorder
 }
 
-// CreateAccess mocks base method.
-func (m *MockPartitionServiceServer) CreateAccess(arg0 context.Context, arg1 *AccessCreateRequest) (*AccessObject, error) {
+// CloseSend mocks base method.
+func (m *MockPartitionService_ListChildPartitionsClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccess", arg0, arg1)
-	ret0, _ := ret[0].(*AccessObject)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateAccess indicates an expected call of CreateAccess.
-func (mr *MockPartitionServiceServerMockRecorder) CreateAccess(arg0, arg1 interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockPartitionService_ListChildPartitionsClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccess", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreateAccess), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockPartitionService_ListChildPartitionsClient)(nil).CloseSend))
 }
 
-// CreateAccessRole mocks base method.
-func (m *MockPartitionServiceServer) CreateAccessRole(arg0 context.Context, arg1 *AccessRoleCreateRequest) (*AccessRoleObject, error) {
+// Context mocks base method.
+func (m *MockPartitionService_ListChildPartitionsClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccessRole", arg0, arg1)
-	ret0, _ := ret[0].(*AccessRoleObject)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// CreateAccessRole indicates an expected call of CreateAccessRole.
-func (mr *MockPartitionServiceServerMockRecorder) CreateAccessRole(arg0, arg1 interface{}) *gomock.Call {
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_ListChildPartitionsClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessRole", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreateAccessRole), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListChildPartitionsClient)(nil).Context))
 }
 
-// CreatePage mocks base method.
-func (m *MockPartitionServiceServer) CreatePage(arg0 context.Context, arg1 *PageCreateRequest) (*PageObject, error) {
+// Header mocks base method.
+func (m *MockPartitionService_ListChildPartitionsClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePage", arg0, arg1)
-	ret0, _ := ret[0].(*PageObject)
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePage indicates an expected call of CreatePage.
-func (mr *MockPartitionServiceServerMockRecorder) CreatePage(arg0, arg1 interface{}) *gomock.Call {
+// Header indicates an expected call of Header.
+func (mr *MockPartitionService_ListChildPartitionsClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePage", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreatePage), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockPartitionService_ListChildPartitionsClient)(nil).Header))
 }
 
-// CreatePartition mocks base method.
-func (m *MockPartitionServiceServer) CreatePartition(arg0 context.Context, arg1 *PartitionCreateRequest) (*PartitionObject, error) {
+// Recv mocks base method.
+func (m *MockPartitionService_ListChildPartitionsClient) Recv() (*PartitionObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePartition", arg0, arg1)
+	ret := m.ctrl.Call(m, "Recv")
 	ret0, _ := ret[0].(*PartitionObject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePartition indicates an expected call of CreatePartition.
-func (mr *MockPartitionServiceServerMockRecorder) CreatePartition(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv.
+func (mr *MockPartitionService_ListChildPartitionsClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePartition", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreatePartition), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockPartitionService_ListChildPartitionsClient)(nil).Recv))
 }
 
-// CreatePartitionRole mocks base method.
-func (m *MockPartitionServiceServer) CreatePartitionRole(arg0 context.Context, arg1 *PartitionRoleCreateRequest) (*PartitionRoleObject, error) {
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_ListChildPartitionsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_ListChildPartitionsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListChildPartitionsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_ListChildPartitionsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_ListChildPartitionsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListChildPartitionsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockPartitionService_ListChildPartitionsClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePartitionRole", arg0, arg1)
-	ret0, _ := ret[0].(*PartitionRoleObject)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
 }
 
-// CreatePartitionRole indicates an expected call of CreatePartitionRole.
-func (mr *MockPartitionServiceServerMockRecorder) CreatePartitionRole(arg0, arg1 interface{}) *gomock.Call {
+// Trailer indicates an expected call of Trailer.
+func (mr *MockPartitionService_ListChildPartitionsClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePartitionRole", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreatePartitionRole), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockPartitionService_ListChildPartitionsClient)(nil).Trailer))
 }
 
-// CreateTenant mocks base method.
-func (m *MockPartitionServiceServer) CreateTenant(arg0 context.Context, arg1 *TenantRequest) (*TenantObject, error) {
+// MockPartitionService_GetPartitionSubtreeClient is a mock of PartitionService_GetPartitionSubtreeClient interface.
+type MockPartitionService_GetPartitionSubtreeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionService_GetPartitionSubtreeClientMockRecorder
+}
+
+// MockPartitionService_GetPartitionSubtreeClientMockRecorder is the mock recorder for MockPartitionService_GetPartitionSubtreeClient.
+type MockPartitionService_GetPartitionSubtreeClientMockRecorder struct {
+	mock *MockPartitionService_GetPartitionSubtreeClient
+}
+
+// NewMockPartitionService_GetPartitionSubtreeClient creates a new mock instance.
+func NewMockPartitionService_GetPartitionSubtreeClient(ctrl *gomock.Controller) *MockPartitionService_GetPartitionSubtreeClient {
+	mock := &MockPartitionService_GetPartitionSubtreeClient{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_GetPartitionSubtreeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionService_GetPartitionSubtreeClient) EXPECT() *MockPartitionService_GetPartitionSubtreeClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTenant", arg0, arg1)
-	ret0, _ := ret[0].(*TenantObject)
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockPartitionService_GetPartitionSubtreeClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_GetPartitionSubtreeClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTenant indicates an expected call of CreateTenant.
-func (mr *MockPartitionServiceServerMockRecorder) CreateTenant(arg0, arg1 interface{}) *gomock.Call {
+// Header indicates an expected call of Header.
+func (mr *MockPartitionService_GetPartitionSubtreeClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreateTenant), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeClient)(nil).Header))
 }
 
-// GetAccess mocks base method.
-func (m *MockPartitionServiceServer) GetAccess(arg0 context.Context, arg1 *AccessGetRequest) (*AccessObject, error) {
+// Recv mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeClient) Recv() (*PartitionObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccess", arg0, arg1)
-	ret0, _ := ret[0].(*AccessObject)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*PartitionObject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccess indicates an expected call of GetAccess.
-func (mr *MockPartitionServiceServerMockRecorder) GetAccess(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv.
+func (mr *MockPartitionService_GetPartitionSubtreeClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccess", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetAccess), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeClient)(nil).Recv))
 }
 
-// GetPage mocks base method.
-func (m *MockPartitionServiceServer) GetPage(arg0 context.Context, arg1 *PageGetRequest) (*PageObject, error) {
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_GetPartitionSubtreeClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_GetPartitionSubtreeClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_GetPartitionSubtreeClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_GetPartitionSubtreeClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPage", arg0, arg1)
-	ret0, _ := ret[0].(*PageObject)
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockPartitionService_GetPartitionSubtreeClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeClient)(nil).Trailer))
+}
+
+// MockPartitionService_ListAccessByProfileClient is a mock of PartitionService_ListAccessByProfileClient interface.
+type MockPartitionService_ListAccessByProfileClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionService_ListAccessByProfileClientMockRecorder
+}
+
+// MockPartitionService_ListAccessByProfileClientMockRecorder is the mock recorder for MockPartitionService_ListAccessByProfileClient.
+type MockPartitionService_ListAccessByProfileClientMockRecorder struct {
+	mock *MockPartitionService_ListAccessByProfileClient
+}
+
+// NewMockPartitionService_ListAccessByProfileClient creates a new mock instance.
+func NewMockPartitionService_ListAccessByProfileClient(ctrl *gomock.Controller) *MockPartitionService_ListAccessByProfileClient {
+	mock := &MockPartitionService_ListAccessByProfileClient{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_ListAccessByProfileClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionService_ListAccessByProfileClient) EXPECT() *MockPartitionService_ListAccessByProfileClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockPartitionService_ListAccessByProfileClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockPartitionService_ListAccessByProfileClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockPartitionService_ListAccessByProfileClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockPartitionService_ListAccessByProfileClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_ListAccessByProfileClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListAccessByProfileClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockPartitionService_ListAccessByProfileClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPage indicates an expected call of GetPage.
-func (mr *MockPartitionServiceServerMockRecorder) GetPage(arg0, arg1 interface{}) *gomock.Call {
+// Header indicates an expected call of Header.
+func (mr *MockPartitionService_ListAccessByProfileClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetPage), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockPartitionService_ListAccessByProfileClient)(nil).Header))
 }
 
-// GetPartition mocks base method.
-func (m *MockPartitionServiceServer) GetPartition(arg0 context.Context, arg1 *GetRequest) (*PartitionObject, error) {
+// Recv mocks base method.
+func (m *MockPartitionService_ListAccessByProfileClient) Recv() (*AccessObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPartition", arg0, arg1)
-	ret0, _ := ret[0].(*PartitionObject)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*AccessObject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPartition indicates an expected call of GetPartition.
-func (mr *MockPartitionServiceServerMockRecorder) GetPartition(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv.
+func (mr *MockPartitionService_ListAccessByProfileClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockPartitionService_ListAccessByProfileClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_ListAccessByProfileClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_ListAccessByProfileClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListAccessByProfileClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_ListAccessByProfileClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_ListAccessByProfileClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListAccessByProfileClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockPartitionService_ListAccessByProfileClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockPartitionService_ListAccessByProfileClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockPartitionService_ListAccessByProfileClient)(nil).Trailer))
+}
+
+// MockPartitionService_ListPartitionAccessClient is a mock of PartitionService_ListPartitionAccessClient interface.
+type MockPartitionService_ListPartitionAccessClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionService_ListPartitionAccessClientMockRecorder
+}
+
+// MockPartitionService_ListPartitionAccessClientMockRecorder is the mock recorder for MockPartitionService_ListPartitionAccessClient.
+type MockPartitionService_ListPartitionAccessClientMockRecorder struct {
+	mock *MockPartitionService_ListPartitionAccessClient
+}
+
+// NewMockPartitionService_ListPartitionAccessClient creates a new mock instance.
+func NewMockPartitionService_ListPartitionAccessClient(ctrl *gomock.Controller) *MockPartitionService_ListPartitionAccessClient {
+	mock := &MockPartitionService_ListPartitionAccessClient{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_ListPartitionAccessClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionService_ListPartitionAccessClient) EXPECT() *MockPartitionService_ListPartitionAccessClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockPartitionService_ListPartitionAccessClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockPartitionService_ListPartitionAccessClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockPartitionService_ListPartitionAccessClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockPartitionService_ListPartitionAccessClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_ListPartitionAccessClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListPartitionAccessClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockPartitionService_ListPartitionAccessClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockPartitionService_ListPartitionAccessClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockPartitionService_ListPartitionAccessClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockPartitionService_ListPartitionAccessClient) Recv() (*AccessObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*AccessObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockPartitionService_ListPartitionAccessClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockPartitionService_ListPartitionAccessClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_ListPartitionAccessClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_ListPartitionAccessClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListPartitionAccessClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_ListPartitionAccessClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_ListPartitionAccessClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListPartitionAccessClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockPartitionService_ListPartitionAccessClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockPartitionService_ListPartitionAccessClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockPartitionService_ListPartitionAccessClient)(nil).Trailer))
+}
+
+// MockPartitionService_WatchClient is a mock of PartitionService_WatchClient interface.
+type MockPartitionService_WatchClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionService_WatchClientMockRecorder
+}
+
+// MockPartitionService_WatchClientMockRecorder is the mock recorder for MockPartitionService_WatchClient.
+type MockPartitionService_WatchClientMockRecorder struct {
+	mock *MockPartitionService_WatchClient
+}
+
+// NewMockPartitionService_WatchClient creates a new mock instance.
+func NewMockPartitionService_WatchClient(ctrl *gomock.Controller) *MockPartitionService_WatchClient {
+	mock := &MockPartitionService_WatchClient{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_WatchClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionService_WatchClient) EXPECT() *MockPartitionService_WatchClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockPartitionService_WatchClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockPartitionService_WatchClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockPartitionService_WatchClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockPartitionService_WatchClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_WatchClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_WatchClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockPartitionService_WatchClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockPartitionService_WatchClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockPartitionService_WatchClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockPartitionService_WatchClient) Recv() (*ChangeEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*ChangeEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockPartitionService_WatchClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockPartitionService_WatchClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_WatchClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_WatchClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_WatchClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_WatchClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_WatchClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_WatchClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockPartitionService_WatchClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockPartitionService_WatchClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockPartitionService_WatchClient)(nil).Trailer))
+}
+
+// MockPartitionServiceServer is a mock of PartitionServiceServer interface.
+type MockPartitionServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionServiceServerMockRecorder
+}
+
+// MockPartitionServiceServerMockRecorder is the mock recorder for MockPartitionServiceServer.
+type MockPartitionServiceServerMockRecorder struct {
+	mock *MockPartitionServiceServer
+}
+
+// NewMockPartitionServiceServer creates a new mock instance.
+func NewMockPartitionServiceServer(ctrl *gomock.Controller) *MockPartitionServiceServer {
+	mock := &MockPartitionServiceServer{ctrl: ctrl}
+	mock.recorder = &MockPartitionServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionServiceServer) EXPECT() *MockPartitionServiceServerMockRecorder {
+	return m.recorder
+}
+
+// CheckAccess mocks base method.
+func (m *MockPartitionServiceServer) CheckAccess(arg0 context.Context, arg1 *AccessCheckRequest) (*AccessCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", arg0, arg1)
+	ret0, _ := ret[0].(*AccessCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockPartitionServiceServerMockRecorder) CheckAccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockPartitionServiceServer)(nil).CheckAccess), arg0, arg1)
+}
+
+// CheckAccessBatch mocks base method.
+func (m *MockPartitionServiceServer) CheckAccessBatch(arg0 context.Context, arg1 *AccessCheckBatchRequest) (*AccessCheckBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccessBatch", arg0, arg1)
+	ret0, _ := ret[0].(*AccessCheckBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAccessBatch indicates an expected call of CheckAccessBatch.
+func (mr *MockPartitionServiceServerMockRecorder) CheckAccessBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccessBatch", reflect.TypeOf((*MockPartitionServiceServer)(nil).CheckAccessBatch), arg0, arg1)
+}
+
+// CreateAccess mocks base method.
+func (m *MockPartitionServiceServer) CreateAccess(arg0 context.Context, arg1 *AccessCreateRequest) (*AccessObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccess", arg0, arg1)
+	ret0, _ := ret[0].(*AccessObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccess indicates an expected call of CreateAccess.
+func (mr *MockPartitionServiceServerMockRecorder) CreateAccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccess", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreateAccess), arg0, arg1)
+}
+
+// CreateAccessRole mocks base method.
+func (m *MockPartitionServiceServer) CreateAccessRole(arg0 context.Context, arg1 *AccessRoleCreateRequest) (*AccessRoleObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessRole", arg0, arg1)
+	ret0, _ := ret[0].(*AccessRoleObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessRole indicates an expected call of CreateAccessRole.
+func (mr *MockPartitionServiceServerMockRecorder) CreateAccessRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessRole", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreateAccessRole), arg0, arg1)
+}
+
+// CreatePage mocks base method.
+func (m *MockPartitionServiceServer) CreatePage(arg0 context.Context, arg1 *PageCreateRequest) (*PageObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePage", arg0, arg1)
+	ret0, _ := ret[0].(*PageObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePage indicates an expected call of CreatePage.
+func (mr *MockPartitionServiceServerMockRecorder) CreatePage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePage", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreatePage), arg0, arg1)
+}
+
+// CreatePartition mocks base method.
+func (m *MockPartitionServiceServer) CreatePartition(arg0 context.Context, arg1 *PartitionCreateRequest) (*PartitionObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePartition", arg0, arg1)
+	ret0, _ := ret[0].(*PartitionObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePartition indicates an expected call of CreatePartition.
+func (mr *MockPartitionServiceServerMockRecorder) CreatePartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePartition", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreatePartition), arg0, arg1)
+}
+
+// CreatePartitionRole mocks base method.
+func (m *MockPartitionServiceServer) CreatePartitionRole(arg0 context.Context, arg1 *PartitionRoleCreateRequest) (*PartitionRoleObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePartitionRole", arg0, arg1)
+	ret0, _ := ret[0].(*PartitionRoleObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePartitionRole indicates an expected call of CreatePartitionRole.
+func (mr *MockPartitionServiceServerMockRecorder) CreatePartitionRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePartitionRole", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreatePartitionRole), arg0, arg1)
+}
+
+// CreateTenant mocks base method.
+func (m *MockPartitionServiceServer) CreateTenant(arg0 context.Context, arg1 *TenantRequest) (*TenantObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenant", arg0, arg1)
+	ret0, _ := ret[0].(*TenantObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTenant indicates an expected call of CreateTenant.
+func (mr *MockPartitionServiceServerMockRecorder) CreateTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockPartitionServiceServer)(nil).CreateTenant), arg0, arg1)
+}
+
+// GetAccess mocks base method.
+func (m *MockPartitionServiceServer) GetAccess(arg0 context.Context, arg1 *AccessGetRequest) (*AccessObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccess", arg0, arg1)
+	ret0, _ := ret[0].(*AccessObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccess indicates an expected call of GetAccess.
+func (mr *MockPartitionServiceServerMockRecorder) GetAccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccess", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetAccess), arg0, arg1)
+}
+
+// GetPage mocks base method.
+func (m *MockPartitionServiceServer) GetPage(arg0 context.Context, arg1 *PageGetRequest) (*PageObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPage", arg0, arg1)
+	ret0, _ := ret[0].(*PageObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPage indicates an expected call of GetPage.
+func (mr *MockPartitionServiceServerMockRecorder) GetPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetPage), arg0, arg1)
+}
+
+// GetPartition mocks base method.
+func (m *MockPartitionServiceServer) GetPartition(arg0 context.Context, arg1 *GetRequest) (*PartitionObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPartition", arg0, arg1)
+	ret0, _ := ret[0].(*PartitionObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPartition indicates an expected call of GetPartition.
+func (mr *MockPartitionServiceServerMockRecorder) GetPartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartition", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetPartition), arg0, arg1)
+}
+
+// GetPartitionAncestors mocks base method.
+func (m *MockPartitionServiceServer) GetPartitionAncestors(arg0 context.Context, arg1 *GetRequest) (*PartitionListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPartitionAncestors", arg0, arg1)
+	ret0, _ := ret[0].(*PartitionListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPartitionAncestors indicates an expected call of GetPartitionAncestors.
+func (mr *MockPartitionServiceServerMockRecorder) GetPartitionAncestors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartitionAncestors", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetPartitionAncestors), arg0, arg1)
+}
+
+// GetPartitionSubtree mocks base method.
+func (m *MockPartitionServiceServer) GetPartitionSubtree(arg0 *PartitionSubtreeRequest, arg1 PartitionService_GetPartitionSubtreeServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPartitionSubtree", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetPartitionSubtree indicates an expected call of GetPartitionSubtree.
+func (mr *MockPartitionServiceServerMockRecorder) GetPartitionSubtree(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartitionSubtree", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetPartitionSubtree), arg0, arg1)
+}
+
+// GetTenant mocks base method.
+func (m *MockPartitionServiceServer) GetTenant(arg0 context.Context, arg1 *GetRequest) (*TenantObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenant", arg0, arg1)
+	ret0, _ := ret[0].(*TenantObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenant indicates an expected call of GetTenant.
+func (mr *MockPartitionServiceServerMockRecorder) GetTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenant", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetTenant), arg0, arg1)
+}
+
+// ListAccessByProfile mocks base method.
+func (m *MockPartitionServiceServer) ListAccessByProfile(arg0 *AccessListByProfileRequest, arg1 PartitionService_ListAccessByProfileServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccessByProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAccessByProfile indicates an expected call of ListAccessByProfile.
+func (mr *MockPartitionServiceServerMockRecorder) ListAccessByProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessByProfile", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListAccessByProfile), arg0, arg1)
+}
+
+// ListAccessRoles mocks base method.
+func (m *MockPartitionServiceServer) ListAccessRoles(arg0 context.Context, arg1 *AccessRoleListRequest) (*AccessRoleListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccessRoles", arg0, arg1)
+	ret0, _ := ret[0].(*AccessRoleListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccessRoles indicates an expected call of ListAccessRoles.
+func (mr *MockPartitionServiceServerMockRecorder) ListAccessRoles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessRoles", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListAccessRoles), arg0, arg1)
+}
+
+// ListChildPartitions mocks base method.
+func (m *MockPartitionServiceServer) ListChildPartitions(arg0 *GetRequest, arg1 PartitionService_ListChildPartitionsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChildPartitions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListChildPartitions indicates an expected call of ListChildPartitions.
+func (mr *MockPartitionServiceServerMockRecorder) ListChildPartitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChildPartitions", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListChildPartitions), arg0, arg1)
+}
+
+// ListPageVersions mocks base method.
+func (m *MockPartitionServiceServer) ListPageVersions(arg0 context.Context, arg1 *PageVersionListRequest) (*PageVersionListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPageVersions", arg0, arg1)
+	ret0, _ := ret[0].(*PageVersionListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPageVersions indicates an expected call of ListPageVersions.
+func (mr *MockPartitionServiceServerMockRecorder) ListPageVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPageVersions", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListPageVersions), arg0, arg1)
+}
+
+// ListPages mocks base method.
+func (m *MockPartitionServiceServer) ListPages(arg0 context.Context, arg1 *PageListRequest) (*PageListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPages", arg0, arg1)
+	ret0, _ := ret[0].(*PageListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPages indicates an expected call of ListPages.
+func (mr *MockPartitionServiceServerMockRecorder) ListPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPages", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListPages), arg0, arg1)
+}
+
+// ListPartition mocks base method.
+func (m *MockPartitionServiceServer) ListPartition(arg0 *SearchRequest, arg1 PartitionService_ListPartitionServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPartition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListPartition indicates an expected call of ListPartition.
+func (mr *MockPartitionServiceServerMockRecorder) ListPartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartition", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListPartition), arg0, arg1)
+}
+
+// ListPartitionAccess mocks base method.
+func (m *MockPartitionServiceServer) ListPartitionAccess(arg0 *PartitionAccessListRequest, arg1 PartitionService_ListPartitionAccessServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPartitionAccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListPartitionAccess indicates an expected call of ListPartitionAccess.
+func (mr *MockPartitionServiceServerMockRecorder) ListPartitionAccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartitionAccess", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListPartitionAccess), arg0, arg1)
+}
+
+// ListPartitionRoles mocks base method.
+func (m *MockPartitionServiceServer) ListPartitionRoles(arg0 context.Context, arg1 *PartitionRoleListRequest) (*PartitionRoleListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPartitionRoles", arg0, arg1)
+	ret0, _ := ret[0].(*PartitionRoleListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPartitionRoles indicates an expected call of ListPartitionRoles.
+func (mr *MockPartitionServiceServerMockRecorder) ListPartitionRoles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartitionRoles", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListPartitionRoles), arg0, arg1)
+}
+
+// ListTenant mocks base method.
+func (m *MockPartitionServiceServer) ListTenant(arg0 *SearchRequest, arg1 PartitionService_ListTenantServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenant", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListTenant indicates an expected call of ListTenant.
+func (mr *MockPartitionServiceServerMockRecorder) ListTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenant", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListTenant), arg0, arg1)
+}
+
+// MovePartition mocks base method.
+func (m *MockPartitionServiceServer) MovePartition(arg0 context.Context, arg1 *PartitionMoveRequest) (*PartitionObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePartition", arg0, arg1)
+	ret0, _ := ret[0].(*PartitionObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePartition indicates an expected call of MovePartition.
+func (mr *MockPartitionServiceServerMockRecorder) MovePartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePartition", reflect.TypeOf((*MockPartitionServiceServer)(nil).MovePartition), arg0, arg1)
+}
+
+// ReactivateTenant mocks base method.
+func (m *MockPartitionServiceServer) ReactivateTenant(arg0 context.Context, arg1 *GetRequest) (*TenantObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReactivateTenant", arg0, arg1)
+	ret0, _ := ret[0].(*TenantObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReactivateTenant indicates an expected call of ReactivateTenant.
+func (mr *MockPartitionServiceServerMockRecorder) ReactivateTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateTenant", reflect.TypeOf((*MockPartitionServiceServer)(nil).ReactivateTenant), arg0, arg1)
+}
+
+// RemoveAccess mocks base method.
+func (m *MockPartitionServiceServer) RemoveAccess(arg0 context.Context, arg1 *AccessRemoveRequest) (*RemoveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAccess", arg0, arg1)
+	ret0, _ := ret[0].(*RemoveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAccess indicates an expected call of RemoveAccess.
+func (mr *MockPartitionServiceServerMockRecorder) RemoveAccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAccess", reflect.TypeOf((*MockPartitionServiceServer)(nil).RemoveAccess), arg0, arg1)
+}
+
+// RemoveAccessRole mocks base method.
+func (m *MockPartitionServiceServer) RemoveAccessRole(arg0 context.Context, arg1 *AccessRoleRemoveRequest) (*RemoveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAccessRole", arg0, arg1)
+	ret0, _ := ret[0].(*RemoveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAccessRole indicates an expected call of RemoveAccessRole.
+func (mr *MockPartitionServiceServerMockRecorder) RemoveAccessRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAccessRole", reflect.TypeOf((*MockPartitionServiceServer)(nil).RemoveAccessRole), arg0, arg1)
+}
+
+// RemovePage mocks base method.
+func (m *MockPartitionServiceServer) RemovePage(arg0 context.Context, arg1 *PageRemoveRequest) (*RemoveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePage", arg0, arg1)
+	ret0, _ := ret[0].(*RemoveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePage indicates an expected call of RemovePage.
+func (mr *MockPartitionServiceServerMockRecorder) RemovePage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePage", reflect.TypeOf((*MockPartitionServiceServer)(nil).RemovePage), arg0, arg1)
+}
+
+// RemovePartitionRole mocks base method.
+func (m *MockPartitionServiceServer) RemovePartitionRole(arg0 context.Context, arg1 *PartitionRoleRemoveRequest) (*RemoveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePartitionRole", arg0, arg1)
+	ret0, _ := ret[0].(*RemoveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePartitionRole indicates an expected call of RemovePartitionRole.
+func (mr *MockPartitionServiceServerMockRecorder) RemovePartitionRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePartitionRole", reflect.TypeOf((*MockPartitionServiceServer)(nil).RemovePartitionRole), arg0, arg1)
+}
+
+// RenderPage mocks base method.
+func (m *MockPartitionServiceServer) RenderPage(arg0 context.Context, arg1 *PageRenderRequest) (*PageRenderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderPage", arg0, arg1)
+	ret0, _ := ret[0].(*PageRenderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderPage indicates an expected call of RenderPage.
+func (mr *MockPartitionServiceServerMockRecorder) RenderPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderPage", reflect.TypeOf((*MockPartitionServiceServer)(nil).RenderPage), arg0, arg1)
+}
+
+// RollbackPage mocks base method.
+func (m *MockPartitionServiceServer) RollbackPage(arg0 context.Context, arg1 *PageRollbackRequest) (*PageObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackPage", arg0, arg1)
+	ret0, _ := ret[0].(*PageObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackPage indicates an expected call of RollbackPage.
+func (mr *MockPartitionServiceServerMockRecorder) RollbackPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackPage", reflect.TypeOf((*MockPartitionServiceServer)(nil).RollbackPage), arg0, arg1)
+}
+
+// SuspendTenant mocks base method.
+func (m *MockPartitionServiceServer) SuspendTenant(arg0 context.Context, arg1 *GetRequest) (*TenantObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendTenant", arg0, arg1)
+	ret0, _ := ret[0].(*TenantObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendTenant indicates an expected call of SuspendTenant.
+func (mr *MockPartitionServiceServerMockRecorder) SuspendTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendTenant", reflect.TypeOf((*MockPartitionServiceServer)(nil).SuspendTenant), arg0, arg1)
+}
+
+// UpdatePage mocks base method.
+func (m *MockPartitionServiceServer) UpdatePage(arg0 context.Context, arg1 *PageUpdateRequest) (*PageObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePage", arg0, arg1)
+	ret0, _ := ret[0].(*PageObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePage indicates an expected call of UpdatePage.
+func (mr *MockPartitionServiceServerMockRecorder) UpdatePage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePage", reflect.TypeOf((*MockPartitionServiceServer)(nil).UpdatePage), arg0, arg1)
+}
+
+// UpdatePartition mocks base method.
+func (m *MockPartitionServiceServer) UpdatePartition(arg0 context.Context, arg1 *PartitionUpdateRequest) (*PartitionObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePartition", arg0, arg1)
+	ret0, _ := ret[0].(*PartitionObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePartition indicates an expected call of UpdatePartition.
+func (mr *MockPartitionServiceServerMockRecorder) UpdatePartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePartition", reflect.TypeOf((*MockPartitionServiceServer)(nil).UpdatePartition), arg0, arg1)
+}
+
+// UpdateTenant mocks base method.
+func (m *MockPartitionServiceServer) UpdateTenant(arg0 context.Context, arg1 *TenantUpdateRequest) (*TenantObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenant", arg0, arg1)
+	ret0, _ := ret[0].(*TenantObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTenant indicates an expected call of UpdateTenant.
+func (mr *MockPartitionServiceServerMockRecorder) UpdateTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockPartitionServiceServer)(nil).UpdateTenant), arg0, arg1)
+}
+
+// Watch mocks base method.
+func (m *MockPartitionServiceServer) Watch(arg0 *WatchRequest, arg1 PartitionService_WatchServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockPartitionServiceServerMockRecorder) Watch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockPartitionServiceServer)(nil).Watch), arg0, arg1)
+}
+
+// mustEmbedUnimplementedPartitionServiceServer mocks base method.
+func (m *MockPartitionServiceServer) mustEmbedUnimplementedPartitionServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedPartitionServiceServer")
+}
+
+// mustEmbedUnimplementedPartitionServiceServer indicates an expected call of mustEmbedUnimplementedPartitionServiceServer.
+func (mr *MockPartitionServiceServerMockRecorder) mustEmbedUnimplementedPartitionServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedPartitionServiceServer", reflect.TypeOf((*MockPartitionServiceServer)(nil).mustEmbedUnimplementedPartitionServiceServer))
+}
+
+// MockUnsafePartitionServiceServer is a mock of UnsafePartitionServiceServer interface.
+type MockUnsafePartitionServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafePartitionServiceServerMockRecorder
+}
+
+// MockUnsafePartitionServiceServerMockRecorder is the mock recorder for MockUnsafePartitionServiceServer.
+type MockUnsafePartitionServiceServerMockRecorder struct {
+	mock *MockUnsafePartitionServiceServer
+}
+
+// NewMockUnsafePartitionServiceServer creates a new mock instance.
+func NewMockUnsafePartitionServiceServer(ctrl *gomock.Controller) *MockUnsafePartitionServiceServer {
+	mock := &MockUnsafePartitionServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafePartitionServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafePartitionServiceServer) EXPECT() *MockUnsafePartitionServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedPartitionServiceServer mocks base method.
+func (m *MockUnsafePartitionServiceServer) mustEmbedUnimplementedPartitionServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedPartitionServiceServer")
+}
+
+// mustEmbedUnimplementedPartitionServiceServer indicates an expected call of mustEmbedUnimplementedPartitionServiceServer.
+func (mr *MockUnsafePartitionServiceServerMockRecorder) mustEmbedUnimplementedPartitionServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedPartitionServiceServer", reflect.TypeOf((*MockUnsafePartitionServiceServer)(nil).mustEmbedUnimplementedPartitionServiceServer))
+}
+
+// MockPartitionService_ListTenantServer is a mock of PartitionService_ListTenantServer interface.
+type MockPartitionService_ListTenantServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionService_ListTenantServerMockRecorder
+}
+
+// MockPartitionService_ListTenantServerMockRecorder is the mock recorder for MockPartitionService_ListTenantServer.
+type MockPartitionService_ListTenantServerMockRecorder struct {
+	mock *MockPartitionService_ListTenantServer
+}
+
+// NewMockPartitionService_ListTenantServer creates a new mock instance.
+func NewMockPartitionService_ListTenantServer(ctrl *gomock.Controller) *MockPartitionService_ListTenantServer {
+	mock := &MockPartitionService_ListTenantServer{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_ListTenantServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionService_ListTenantServer) EXPECT() *MockPartitionService_ListTenantServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockPartitionService_ListTenantServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_ListTenantServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_ListTenantServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_ListTenantServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockPartitionService_ListTenantServer) Send(arg0 *TenantObject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPartitionService_ListTenantServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockPartitionService_ListTenantServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockPartitionService_ListTenantServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_ListTenantServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_ListTenantServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockPartitionService_ListTenantServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockPartitionService_ListTenantServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockPartitionService_ListTenantServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockPartitionService_ListTenantServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).SetTrailer), arg0)
+}
+
+// MockPartitionService_ListPartitionServer is a mock of PartitionService_ListPartitionServer interface.
+type MockPartitionService_ListPartitionServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionService_ListPartitionServerMockRecorder
+}
+
+// MockPartitionService_ListPartitionServerMockRecorder is the mock recorder for MockPartitionService_ListPartitionServer.
+type MockPartitionService_ListPartitionServerMockRecorder struct {
+	mock *MockPartitionService_ListPartitionServer
+}
+
+// NewMockPartitionService_ListPartitionServer creates a new mock instance.
+func NewMockPartitionService_ListPartitionServer(ctrl *gomock.Controller) *MockPartitionService_ListPartitionServer {
+	mock := &MockPartitionService_ListPartitionServer{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_ListPartitionServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionService_ListPartitionServer) EXPECT() *MockPartitionService_ListPartitionServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockPartitionService_ListPartitionServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_ListPartitionServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_ListPartitionServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_ListPartitionServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockPartitionService_ListPartitionServer) Send(arg0 *PartitionObject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPartitionService_ListPartitionServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockPartitionService_ListPartitionServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockPartitionService_ListPartitionServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_ListPartitionServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_ListPartitionServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockPartitionService_ListPartitionServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockPartitionService_ListPartitionServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockPartitionService_ListPartitionServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockPartitionService_ListPartitionServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).SetTrailer), arg0)
+}
+
+// MockPartitionService_ListChildPartitionsServer is a mock of PartitionService_ListChildPartitionsServer interface.
+type MockPartitionService_ListChildPartitionsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionService_ListChildPartitionsServerMockRecorder
+}
+
+// MockPartitionService_ListChildPartitionsServerMockRecorder is the mock recorder for MockPartitionService_ListChildPartitionsServer.
+type MockPartitionService_ListChildPartitionsServerMockRecorder struct {
+	mock *MockPartitionService_ListChildPartitionsServer
+}
+
+// NewMockPartitionService_ListChildPartitionsServer creates a new mock instance.
+func NewMockPartitionService_ListChildPartitionsServer(ctrl *gomock.Controller) *MockPartitionService_ListChildPartitionsServer {
+	mock := &MockPartitionService_ListChildPartitionsServer{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_ListChildPartitionsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionService_ListChildPartitionsServer) EXPECT() *MockPartitionService_ListChildPartitionsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockPartitionService_ListChildPartitionsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_ListChildPartitionsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListChildPartitionsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_ListChildPartitionsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_ListChildPartitionsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListChildPartitionsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockPartitionService_ListChildPartitionsServer) Send(arg0 *PartitionObject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPartitionService_ListChildPartitionsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPartitionService_ListChildPartitionsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockPartitionService_ListChildPartitionsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockPartitionService_ListChildPartitionsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPartitionService_ListChildPartitionsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_ListChildPartitionsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_ListChildPartitionsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListChildPartitionsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockPartitionService_ListChildPartitionsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockPartitionService_ListChildPartitionsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPartitionService_ListChildPartitionsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockPartitionService_ListChildPartitionsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockPartitionService_ListChildPartitionsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPartitionService_ListChildPartitionsServer)(nil).SetTrailer), arg0)
+}
+
+// MockPartitionService_GetPartitionSubtreeServer is a mock of PartitionService_GetPartitionSubtreeServer interface.
+type MockPartitionService_GetPartitionSubtreeServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionService_GetPartitionSubtreeServerMockRecorder
+}
+
+// MockPartitionService_GetPartitionSubtreeServerMockRecorder is the mock recorder for MockPartitionService_GetPartitionSubtreeServer.
+type MockPartitionService_GetPartitionSubtreeServerMockRecorder struct {
+	mock *MockPartitionService_GetPartitionSubtreeServer
+}
+
+// NewMockPartitionService_GetPartitionSubtreeServer creates a new mock instance.
+func NewMockPartitionService_GetPartitionSubtreeServer(ctrl *gomock.Controller) *MockPartitionService_GetPartitionSubtreeServer {
+	mock := &MockPartitionService_GetPartitionSubtreeServer{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_GetPartitionSubtreeServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionService_GetPartitionSubtreeServer) EXPECT() *MockPartitionService_GetPartitionSubtreeServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_GetPartitionSubtreeServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartition", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetPartition), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeServer)(nil).Context))
 }
 
-// GetTenant mocks base method.
-func (m *MockPartitionServiceServer) GetTenant(arg0 context.Context, arg1 *GetRequest) (*TenantObject, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTenant", arg0, arg1)
-	ret0, _ := ret[0].(*TenantObject)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_GetPartitionSubtreeServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTenant indicates an expected call of GetTenant.
-func (mr *MockPartitionServiceServerMockRecorder) GetTenant(arg0, arg1 interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_GetPartitionSubtreeServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenant", reflect.TypeOf((*MockPartitionServiceServer)(nil).GetTenant), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeServer)(nil).RecvMsg), m)
 }
 
-// ListAccessRoles mocks base method.
-func (m *MockPartitionServiceServer) ListAccessRoles(arg0 context.Context, arg1 *AccessRoleListRequest) (*AccessRoleListResponse, error) {
+// Send mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeServer) Send(arg0 *PartitionObject) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccessRoles", arg0, arg1)
-	ret0, _ := ret[0].(*AccessRoleListResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListAccessRoles indicates an expected call of ListAccessRoles.
-func (mr *MockPartitionServiceServerMockRecorder) ListAccessRoles(arg0, arg1 interface{}) *gomock.Call {
+// Send indicates an expected call of Send.
+func (mr *MockPartitionService_GetPartitionSubtreeServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessRoles", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListAccessRoles), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeServer)(nil).Send), arg0)
 }
 
-// ListPartition mocks base method.
-func (m *MockPartitionServiceServer) ListPartition(arg0 *SearchRequest, arg1 PartitionService_ListPartitionServer) error {
+// SendHeader mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPartition", arg0, arg1)
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ListPartition indicates an expected call of ListPartition.
-func (mr *MockPartitionServiceServerMockRecorder) ListPartition(arg0, arg1 interface{}) *gomock.Call {
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockPartitionService_GetPartitionSubtreeServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartition", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListPartition), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeServer)(nil).SendHeader), arg0)
 }
 
-// ListPartitionRoles mocks base method.
-func (m *MockPartitionServiceServer) ListPartitionRoles(arg0 context.Context, arg1 *PartitionRoleListRequest) (*PartitionRoleListResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPartitionRoles", arg0, arg1)
-	ret0, _ := ret[0].(*PartitionRoleListResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_GetPartitionSubtreeServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListPartitionRoles indicates an expected call of ListPartitionRoles.
-func (mr *MockPartitionServiceServerMockRecorder) ListPartitionRoles(arg0, arg1 interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_GetPartitionSubtreeServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartitionRoles", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListPartitionRoles), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeServer)(nil).SendMsg), m)
 }
 
-// ListTenant mocks base method.
-func (m *MockPartitionServiceServer) ListTenant(arg0 *SearchRequest, arg1 PartitionService_ListTenantServer) error {
+// SetHeader mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTenant", arg0, arg1)
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ListTenant indicates an expected call of ListTenant.
-func (mr *MockPartitionServiceServerMockRecorder) ListTenant(arg0, arg1 interface{}) *gomock.Call {
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockPartitionService_GetPartitionSubtreeServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenant", reflect.TypeOf((*MockPartitionServiceServer)(nil).ListTenant), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeServer)(nil).SetHeader), arg0)
 }
 
-// RemoveAccess mocks base method.
-func (m *MockPartitionServiceServer) RemoveAccess(arg0 context.Context, arg1 *AccessRemoveRequest) (*RemoveResponse, error) {
+// SetTrailer mocks base method.
+func (m *MockPartitionService_GetPartitionSubtreeServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveAccess", arg0, arg1)
-	ret0, _ := ret[0].(*RemoveResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
-// RemoveAccess indicates an expected call of RemoveAccess.
-func (mr *MockPartitionServiceServerMockRecorder) RemoveAccess(arg0, arg1 interface{}) *gomock.Call {
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockPartitionService_GetPartitionSubtreeServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAccess", reflect.TypeOf((*MockPartitionServiceServer)(nil).RemoveAccess), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPartitionService_GetPartitionSubtreeServer)(nil).SetTrailer), arg0)
 }
 
-// RemoveAccessRole mocks base method.
-func (m *MockPartitionServiceServer) RemoveAccessRole(arg0 context.Context, arg1 *AccessRoleRemoveRequest) (*RemoveResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveAccessRole", arg0, arg1)
-	ret0, _ := ret[0].(*RemoveResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockPartitionService_ListAccessByProfileServer is a mock of PartitionService_ListAccessByProfileServer interface.
+type MockPartitionService_ListAccessByProfileServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionService_ListAccessByProfileServerMockRecorder
 }
 
-// RemoveAccessRole indicates an expected call of RemoveAccessRole.
-func (mr *MockPartitionServiceServerMockRecorder) RemoveAccessRole(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAccessRole", reflect.TypeOf((*MockPartitionServiceServer)(nil).RemoveAccessRole), arg0, arg1)
+// MockPartitionService_ListAccessByProfileServerMockRecorder is the mock recorder for MockPartitionService_ListAccessByProfileServer.
+type MockPartitionService_ListAccessByProfileServerMockRecorder struct {
+	mock *MockPartitionService_ListAccessByProfileServer
 }
 
-// RemovePage mocks base method.
-func (m *MockPartitionServiceServer) RemovePage(arg0 context.Context, arg1 *PageRemoveRequest) (*RemoveResponse, error) {
+// NewMockPartitionService_ListAccessByProfileServer creates a new mock instance.
+func NewMockPartitionService_ListAccessByProfileServer(ctrl *gomock.Controller) *MockPartitionService_ListAccessByProfileServer {
+	mock := &MockPartitionService_ListAccessByProfileServer{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_ListAccessByProfileServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartitionService_ListAccessByProfileServer) EXPECT() *MockPartitionService_ListAccessByProfileServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockPartitionService_ListAccessByProfileServer) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemovePage", arg0, arg1)
-	ret0, _ := ret[0].(*RemoveResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// RemovePage indicates an expected call of RemovePage.
-func (mr *MockPartitionServiceServerMockRecorder) RemovePage(arg0, arg1 interface{}) *gomock.Call {
+// Context indicates an expected call of Context.
+func (mr *MockPartitionService_ListAccessByProfileServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePage", reflect.TypeOf((*MockPartitionServiceServer)(nil).RemovePage), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListAccessByProfileServer)(nil).Context))
 }
 
-// RemovePartitionRole mocks base method.
-func (m *MockPartitionServiceServer) RemovePartitionRole(arg0 context.Context, arg1 *PartitionRoleRemoveRequest) (*RemoveResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemovePartitionRole", arg0, arg1)
-	ret0, _ := ret[0].(*RemoveResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// RecvMsg mocks base method.
+func (m_2 *MockPartitionService_ListAccessByProfileServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RemovePartitionRole indicates an expected call of RemovePartitionRole.
-func (mr *MockPartitionServiceServerMockRecorder) RemovePartitionRole(arg0, arg1 interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPartitionService_ListAccessByProfileServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePartitionRole", reflect.TypeOf((*MockPartitionServiceServer)(nil).RemovePartitionRole), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListAccessByProfileServer)(nil).RecvMsg), m)
 }
 
-// UpdatePartition mocks base method.
-func (m *MockPartitionServiceServer) UpdatePartition(arg0 context.Context, arg1 *PartitionUpdateRequest) (*PartitionObject, error) {
+// Send mocks base method.
+func (m *MockPartitionService_ListAccessByProfileServer) Send(arg0 *AccessObject) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePartition", arg0, arg1)
-	ret0, _ := ret[0].(*PartitionObject)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// UpdatePartition indicates an expected call of UpdatePartition.
-func (mr *MockPartitionServiceServerMockRecorder) UpdatePartition(arg0, arg1 interface{}) *gomock.Call {
+// Send indicates an expected call of Send.
+func (mr *MockPartitionService_ListAccessByProfileServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePartition", reflect.TypeOf((*MockPartitionServiceServer)(nil).UpdatePartition), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPartitionService_ListAccessByProfileServer)(nil).Send), arg0)
 }
 
-// mustEmbedUnimplementedPartitionServiceServer mocks base method.
-func (m *MockPartitionServiceServer) mustEmbedUnimplementedPartitionServiceServer() {
+// SendHeader mocks base method.
+func (m *MockPartitionService_ListAccessByProfileServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedPartitionServiceServer")
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// mustEmbedUnimplementedPartitionServiceServer indicates an expected call of mustEmbedUnimplementedPartitionServiceServer.
-func (mr *MockPartitionServiceServerMockRecorder) mustEmbedUnimplementedPartitionServiceServer() *gomock.Call {
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockPartitionService_ListAccessByProfileServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedPartitionServiceServer", reflect.TypeOf((*MockPartitionServiceServer)(nil).mustEmbedUnimplementedPartitionServiceServer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPartitionService_ListAccessByProfileServer)(nil).SendHeader), arg0)
 }
 
-// MockUnsafePartitionServiceServer is a mock of UnsafePartitionServiceServer interface.
-type MockUnsafePartitionServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockUnsafePartitionServiceServerMockRecorder
+// SendMsg mocks base method.
+func (m_2 *MockPartitionService_ListAccessByProfileServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockUnsafePartitionServiceServerMockRecorder is the mock recorder for MockUnsafePartitionServiceServer.
-type MockUnsafePartitionServiceServerMockRecorder struct {
-	mock *MockUnsafePartitionServiceServer
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPartitionService_ListAccessByProfileServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListAccessByProfileServer)(nil).SendMsg), m)
 }
 
-// NewMockUnsafePartitionServiceServer creates a new mock instance.
-func NewMockUnsafePartitionServiceServer(ctrl *gomock.Controller) *MockUnsafePartitionServiceServer {
-	mock := &MockUnsafePartitionServiceServer{ctrl: ctrl}
-	mock.recorder = &MockUnsafePartitionServiceServerMockRecorder{mock}
-	return mock
+// SetHeader mocks base method.
+func (m *MockPartitionService_ListAccessByProfileServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUnsafePartitionServiceServer) EXPECT() *MockUnsafePartitionServiceServerMockRecorder {
-	return m.recorder
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockPartitionService_ListAccessByProfileServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPartitionService_ListAccessByProfileServer)(nil).SetHeader), arg0)
 }
 
-// mustEmbedUnimplementedPartitionServiceServer mocks base method.
-func (m *MockUnsafePartitionServiceServer) mustEmbedUnimplementedPartitionServiceServer() {
+// SetTrailer mocks base method.
+func (m *MockPartitionService_ListAccessByProfileServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedPartitionServiceServer")
+	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
-// mustEmbedUnimplementedPartitionServiceServer indicates an expected call of mustEmbedUnimplementedPartitionServiceServer.
-func (mr *MockUnsafePartitionServiceServerMockRecorder) mustEmbedUnimplementedPartitionServiceServer() *gomock.Call {
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockPartitionService_ListAccessByProfileServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedPartitionServiceServer", reflect.TypeOf((*MockUnsafePartitionServiceServer)(nil).mustEmbedUnimplementedPartitionServiceServer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPartitionService_ListAccessByProfileServer)(nil).SetTrailer), arg0)
 }
 
-// MockPartitionService_ListTenantServer is a mock of PartitionService_ListTenantServer interface.
-type MockPartitionService_ListTenantServer struct {
+// MockPartitionService_ListPartitionAccessServer is a mock of PartitionService_ListPartitionAccessServer interface.
+type MockPartitionService_ListPartitionAccessServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockPartitionService_ListTenantServerMockRecorder
+	recorder *MockPartitionService_ListPartitionAccessServerMockRecorder
 }
 
-// MockPartitionService_ListTenantServerMockRecorder is the mock recorder for MockPartitionService_ListTenantServer.
-type MockPartitionService_ListTenantServerMockRecorder struct {
-	mock *MockPartitionService_ListTenantServer
+// MockPartitionService_ListPartitionAccessServerMockRecorder is the mock recorder for MockPartitionService_ListPartitionAccessServer.
+type MockPartitionService_ListPartitionAccessServerMockRecorder struct {
+	mock *MockPartitionService_ListPartitionAccessServer
 }
 
-// NewMockPartitionService_ListTenantServer creates a new mock instance.
-func NewMockPartitionService_ListTenantServer(ctrl *gomock.Controller) *MockPartitionService_ListTenantServer {
-	mock := &MockPartitionService_ListTenantServer{ctrl: ctrl}
-	mock.recorder = &MockPartitionService_ListTenantServerMockRecorder{mock}
+// NewMockPartitionService_ListPartitionAccessServer creates a new mock instance.
+func NewMockPartitionService_ListPartitionAccessServer(ctrl *gomock.Controller) *MockPartitionService_ListPartitionAccessServer {
+	mock := &MockPartitionService_ListPartitionAccessServer{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_ListPartitionAccessServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockPartitionService_ListTenantServer) EXPECT() *MockPartitionService_ListTenantServerMockRecorder {
+func (m *MockPartitionService_ListPartitionAccessServer) EXPECT() *MockPartitionService_ListPartitionAccessServerMockRecorder {
 	return m.recorder
 }
 
 // Context mocks base method.
-func (m *MockPartitionService_ListTenantServer) Context() context.Context {
+func (m *MockPartitionService_ListPartitionAccessServer) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -1047,13 +2847,13 @@ func (m *MockPartitionService_ListTenantServer) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockPartitionService_ListTenantServerMockRecorder) Context() *gomock.Call {
+func (mr *MockPartitionService_ListPartitionAccessServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListPartitionAccessServer)(nil).Context))
 }
 
 // RecvMsg mocks base method.
-func (m_2 *MockPartitionService_ListTenantServer) RecvMsg(m interface{}) error {
+func (m_2 *MockPartitionService_ListPartitionAccessServer) RecvMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
 	ret0, _ := ret[0].(error)
@@ -1061,13 +2861,13 @@ func (m_2 *MockPartitionService_ListTenantServer) RecvMsg(m interface{}) error {
 }
 
 // RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockPartitionService_ListTenantServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+func (mr *MockPartitionService_ListPartitionAccessServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).RecvMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListPartitionAccessServer)(nil).RecvMsg), m)
 }
 
 // Send mocks base method.
-func (m *MockPartitionService_ListTenantServer) Send(arg0 *TenantObject) error {
+func (m *MockPartitionService_ListPartitionAccessServer) Send(arg0 *AccessObject) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0)
 	ret0, _ := ret[0].(error)
@@ -1075,13 +2875,13 @@ func (m *MockPartitionService_ListTenantServer) Send(arg0 *TenantObject) error {
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockPartitionService_ListTenantServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+func (mr *MockPartitionService_ListPartitionAccessServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).Send), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPartitionService_ListPartitionAccessServer)(nil).Send), arg0)
 }
 
 // SendHeader mocks base method.
-func (m *MockPartitionService_ListTenantServer) SendHeader(arg0 metadata.MD) error {
+func (m *MockPartitionService_ListPartitionAccessServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -1089,13 +2889,13 @@ func (m *MockPartitionService_ListTenantServer) SendHeader(arg0 metadata.MD) err
 }
 
 // SendHeader indicates an expected call of SendHeader.
-func (mr *MockPartitionService_ListTenantServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockPartitionService_ListPartitionAccessServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).SendHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPartitionService_ListPartitionAccessServer)(nil).SendHeader), arg0)
 }
 
 // SendMsg mocks base method.
-func (m_2 *MockPartitionService_ListTenantServer) SendMsg(m interface{}) error {
+func (m_2 *MockPartitionService_ListPartitionAccessServer) SendMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
 	ret0, _ := ret[0].(error)
@@ -1103,13 +2903,13 @@ func (m_2 *MockPartitionService_ListTenantServer) SendMsg(m interface{}) error {
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockPartitionService_ListTenantServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+func (mr *MockPartitionService_ListPartitionAccessServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).SendMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListPartitionAccessServer)(nil).SendMsg), m)
 }
 
 // SetHeader mocks base method.
-func (m *MockPartitionService_ListTenantServer) SetHeader(arg0 metadata.MD) error {
+func (m *MockPartitionService_ListPartitionAccessServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -1117,48 +2917,48 @@ func (m *MockPartitionService_ListTenantServer) SetHeader(arg0 metadata.MD) erro
 }
 
 // SetHeader indicates an expected call of SetHeader.
-func (mr *MockPartitionService_ListTenantServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockPartitionService_ListPartitionAccessServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).SetHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPartitionService_ListPartitionAccessServer)(nil).SetHeader), arg0)
 }
 
 // SetTrailer mocks base method.
-func (m *MockPartitionService_ListTenantServer) SetTrailer(arg0 metadata.MD) {
+func (m *MockPartitionService_ListPartitionAccessServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
 // SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockPartitionService_ListTenantServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+func (mr *MockPartitionService_ListPartitionAccessServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPartitionService_ListTenantServer)(nil).SetTrailer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPartitionService_ListPartitionAccessServer)(nil).SetTrailer), arg0)
 }
 
-// MockPartitionService_ListPartitionServer is a mock of PartitionService_ListPartitionServer interface.
-type MockPartitionService_ListPartitionServer struct {
+// MockPartitionService_WatchServer is a mock of PartitionService_WatchServer interface.
+type MockPartitionService_WatchServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockPartitionService_ListPartitionServerMockRecorder
+	recorder *MockPartitionService_WatchServerMockRecorder
 }
 
-// MockPartitionService_ListPartitionServerMockRecorder is the mock recorder for MockPartitionService_ListPartitionServer.
-type MockPartitionService_ListPartitionServerMockRecorder struct {
-	mock *MockPartitionService_ListPartitionServer
+// MockPartitionService_WatchServerMockRecorder is the mock recorder for MockPartitionService_WatchServer.
+type MockPartitionService_WatchServerMockRecorder struct {
+	mock *MockPartitionService_WatchServer
 }
 
-// NewMockPartitionService_ListPartitionServer creates a new mock instance.
-func NewMockPartitionService_ListPartitionServer(ctrl *gomock.Controller) *MockPartitionService_ListPartitionServer {
-	mock := &MockPartitionService_ListPartitionServer{ctrl: ctrl}
-	mock.recorder = &MockPartitionService_ListPartitionServerMockRecorder{mock}
+// NewMockPartitionService_WatchServer creates a new mock instance.
+func NewMockPartitionService_WatchServer(ctrl *gomock.Controller) *MockPartitionService_WatchServer {
+	mock := &MockPartitionService_WatchServer{ctrl: ctrl}
+	mock.recorder = &MockPartitionService_WatchServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockPartitionService_ListPartitionServer) EXPECT() *MockPartitionService_ListPartitionServerMockRecorder {
+func (m *MockPartitionService_WatchServer) EXPECT() *MockPartitionService_WatchServerMockRecorder {
 	return m.recorder
 }
 
 // Context mocks base method.
-func (m *MockPartitionService_ListPartitionServer) Context() context.Context {
+func (m *MockPartitionService_WatchServer) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -1166,13 +2966,13 @@ func (m *MockPartitionService_ListPartitionServer) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockPartitionService_ListPartitionServerMockRecorder) Context() *gomock.Call {
+func (mr *MockPartitionService_WatchServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPartitionService_WatchServer)(nil).Context))
 }
 
 // RecvMsg mocks base method.
-func (m_2 *MockPartitionService_ListPartitionServer) RecvMsg(m interface{}) error {
+func (m_2 *MockPartitionService_WatchServer) RecvMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
 	ret0, _ := ret[0].(error)
@@ -1180,13 +2980,13 @@ func (m_2 *MockPartitionService_ListPartitionServer) RecvMsg(m interface{}) erro
 }
 
 // RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockPartitionService_ListPartitionServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+func (mr *MockPartitionService_WatchServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).RecvMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPartitionService_WatchServer)(nil).RecvMsg), m)
 }
 
 // Send mocks base method.
-func (m *MockPartitionService_ListPartitionServer) Send(arg0 *PartitionObject) error {
+func (m *MockPartitionService_WatchServer) Send(arg0 *ChangeEvent) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0)
 	ret0, _ := ret[0].(error)
@@ -1194,13 +2994,13 @@ func (m *MockPartitionService_ListPartitionServer) Send(arg0 *PartitionObject) e
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockPartitionService_ListPartitionServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+func (mr *MockPartitionService_WatchServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).Send), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPartitionService_WatchServer)(nil).Send), arg0)
 }
 
 // SendHeader mocks base method.
-func (m *MockPartitionService_ListPartitionServer) SendHeader(arg0 metadata.MD) error {
+func (m *MockPartitionService_WatchServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -1208,13 +3008,13 @@ func (m *MockPartitionService_ListPartitionServer) SendHeader(arg0 metadata.MD)
 }
 
 // SendHeader indicates an expected call of SendHeader.
-func (mr *MockPartitionService_ListPartitionServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockPartitionService_WatchServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).SendHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPartitionService_WatchServer)(nil).SendHeader), arg0)
 }
 
 // SendMsg mocks base method.
-func (m_2 *MockPartitionService_ListPartitionServer) SendMsg(m interface{}) error {
+func (m_2 *MockPartitionService_WatchServer) SendMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
 	ret0, _ := ret[0].(error)
@@ -1222,13 +3022,13 @@ func (m_2 *MockPartitionService_ListPartitionServer) SendMsg(m interface{}) erro
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockPartitionService_ListPartitionServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+func (mr *MockPartitionService_WatchServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).SendMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPartitionService_WatchServer)(nil).SendMsg), m)
 }
 
 // SetHeader mocks base method.
-func (m *MockPartitionService_ListPartitionServer) SetHeader(arg0 metadata.MD) error {
+func (m *MockPartitionService_WatchServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -1236,19 +3036,19 @@ func (m *MockPartitionService_ListPartitionServer) SetHeader(arg0 metadata.MD) e
 }
 
 // SetHeader indicates an expected call of SetHeader.
-func (mr *MockPartitionService_ListPartitionServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockPartitionService_WatchServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).SetHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPartitionService_WatchServer)(nil).SetHeader), arg0)
 }
 
 // SetTrailer mocks base method.
-func (m *MockPartitionService_ListPartitionServer) SetTrailer(arg0 metadata.MD) {
+func (m *MockPartitionService_WatchServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
 // SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockPartitionService_ListPartitionServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+func (mr *MockPartitionService_WatchServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPartitionService_ListPartitionServer)(nil).SetTrailer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPartitionService_WatchServer)(nil).SetTrailer), arg0)
 }
@@ -0,0 +1,39 @@
+package partitionv1
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Timestamped is satisfied by every resource object that records when it was created and last modified.
+type Timestamped interface {
+	GetCreatedAt() *timestamppb.Timestamp
+	GetModifiedAt() *timestamppb.Timestamp
+}
+
+var (
+	_ Timestamped = (*TenantObject)(nil)
+	_ Timestamped = (*PartitionObject)(nil)
+	_ Timestamped = (*PartitionRoleObject)(nil)
+	_ Timestamped = (*PageObject)(nil)
+	_ Timestamped = (*AccessObject)(nil)
+	_ Timestamped = (*AccessRoleObject)(nil)
+)
+
+// CreatedTime returns when obj was created, or the zero time if the service did not supply it.
+func CreatedTime(obj Timestamped) time.Time {
+	return asTime(obj.GetCreatedAt())
+}
+
+// ModifiedTime returns when obj was last modified, or the zero time if the service did not supply it.
+func ModifiedTime(obj Timestamped) time.Time {
+	return asTime(obj.GetModifiedAt())
+}
+
+func asTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,23 @@
+package partitionv1
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTimestampHelpers(t *testing.T) {
+	created := time.Date(2022, time.March, 4, 10, 30, 0, 0, time.UTC)
+	access := &AccessObject{CreatedAt: timestamppb.New(created)}
+
+	if got := CreatedTime(access); !got.Equal(created) {
+		t.Errorf("created time is %v, expected %v", got, created)
+	}
+	if got := ModifiedTime(access); !got.IsZero() {
+		t.Errorf("expected a zero time when the modification time is missing, got %v", got)
+	}
+	if got := CreatedTime((*PartitionObject)(nil)); !got.IsZero() {
+		t.Errorf("expected a zero time for a nil object, got %v", got)
+	}
+}